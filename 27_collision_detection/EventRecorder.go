@@ -0,0 +1,158 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//Recording file header
+const recordingHeader = "#lazyfoo input recording v1"
+
+//EventSource hands out the events of each frame
+type EventSource interface {
+	//PollEvent gets the next event of the current frame, nil when there are none left
+	PollEvent() sdl.Event
+
+	//NextFrame moves on to the next frame
+	NextFrame()
+}
+
+//LiveEventSource reads events straight from SDL
+type LiveEventSource struct{}
+
+//PollEvent polls the SDL event queue
+func (ls LiveEventSource) PollEvent() sdl.Event {
+	return sdl.PollEvent()
+}
+
+//NextFrame does nothing since SDL keeps its own queue
+func (ls LiveEventSource) NextFrame() {}
+
+//EventRecorder writes every event read from a source to a recording file
+type EventRecorder struct {
+	//Where the events come from
+	mSource EventSource
+
+	//Recording file
+	mFile   *os.File
+	mWriter *bufio.Writer
+
+	//Current frame number
+	mFrame uint64
+}
+
+//NewEventRecorder creates the recording file and writes its header
+func NewEventRecorder(source EventSource, path string, seed int64) (*EventRecorder, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not create recording file: %v", err)
+	}
+
+	er := &EventRecorder{mSource: source, mFile: file, mWriter: bufio.NewWriter(file)}
+
+	//Write header and random seed
+	fmt.Fprintln(er.mWriter, recordingHeader)
+	fmt.Fprintln(er.mWriter, "seed", seed)
+
+	return er, nil
+}
+
+//PollEvent gets the next event from the source and records it
+func (er *EventRecorder) PollEvent() sdl.Event {
+	e := er.mSource.PollEvent()
+	if e != nil {
+		if line := formatEvent(e); line != "" {
+			fmt.Fprintln(er.mWriter, er.mFrame, line)
+		}
+	}
+
+	return e
+}
+
+//NextFrame flushes the frame to disk and moves on to the next one
+func (er *EventRecorder) NextFrame() {
+	//Flush every frame so a crash still leaves a usable recording
+	if err := er.mWriter.Flush(); err != nil {
+		fmt.Printf("Warning: could not write recording: %v\n", err)
+	}
+
+	er.mSource.NextFrame()
+	er.mFrame++
+}
+
+//Frame gets the current frame number
+func (er *EventRecorder) Frame() uint64 {
+	return er.mFrame
+}
+
+//Close writes the last frame and closes the recording file
+func (er *EventRecorder) Close() error {
+	//Mark the end so the replay lasts as long as the recording
+	fmt.Fprintln(er.mWriter, er.mFrame, "end")
+
+	if err := er.mWriter.Flush(); err != nil {
+		return fmt.Errorf("could not write recording: %v", err)
+	}
+	if err := er.mFile.Close(); err != nil {
+		return fmt.Errorf("could not close recording file: %v", err)
+	}
+
+	return nil
+}
+
+//formatEvent turns an event into its recording line, empty for events that are not recorded
+func formatEvent(e sdl.Event) string {
+	switch e.GetType() {
+	case sdl.QUIT:
+		return fmt.Sprintf("quit %d", e.GetTimestamp())
+	case sdl.KEYDOWN, sdl.KEYUP:
+		k := e.(*sdl.KeyboardEvent)
+		return fmt.Sprintf("%s %d %d %d %d %d %d %d", eventName(k.Type), k.Timestamp, k.WindowID,
+			k.State, k.Repeat, k.Keysym.Scancode, k.Keysym.Sym, k.Keysym.Mod)
+	case sdl.TEXTINPUT:
+		t := e.(*sdl.TextInputEvent)
+		return fmt.Sprintf("textinput %d %d %s", t.Timestamp, t.WindowID, strconv.Quote(t.GetText()))
+	case sdl.MOUSEMOTION:
+		m := e.(*sdl.MouseMotionEvent)
+		return fmt.Sprintf("mousemotion %d %d %d %d %d %d %d %d", m.Timestamp, m.WindowID, m.Which,
+			m.State, m.X, m.Y, m.XRel, m.YRel)
+	case sdl.MOUSEBUTTONDOWN, sdl.MOUSEBUTTONUP:
+		m := e.(*sdl.MouseButtonEvent)
+		return fmt.Sprintf("%s %d %d %d %d %d %d %d %d", eventName(m.Type), m.Timestamp, m.WindowID,
+			m.Which, m.Button, m.State, m.Clicks, m.X, m.Y)
+	case sdl.MOUSEWHEEL:
+		m := e.(*sdl.MouseWheelEvent)
+		return fmt.Sprintf("mousewheel %d %d %d %d %d %d", m.Timestamp, m.WindowID, m.Which,
+			m.X, m.Y, m.Direction)
+	case sdl.JOYAXISMOTION:
+		j := e.(*sdl.JoyAxisEvent)
+		return fmt.Sprintf("joyaxis %d %d %d %d", j.Timestamp, j.Which, j.Axis, j.Value)
+	case sdl.JOYHATMOTION:
+		j := e.(*sdl.JoyHatEvent)
+		return fmt.Sprintf("joyhat %d %d %d %d", j.Timestamp, j.Which, j.Hat, j.Value)
+	case sdl.JOYBUTTONDOWN, sdl.JOYBUTTONUP:
+		j := e.(*sdl.JoyButtonEvent)
+		return fmt.Sprintf("%s %d %d %d %d", eventName(j.Type), j.Timestamp, j.Which, j.Button, j.State)
+	}
+
+	return ""
+}
+
+//Names of the event types that have more than one type per struct
+var eventNames = map[uint32]string{
+	sdl.KEYDOWN:         "keydown",
+	sdl.KEYUP:           "keyup",
+	sdl.MOUSEBUTTONDOWN: "mousebuttondown",
+	sdl.MOUSEBUTTONUP:   "mousebuttonup",
+	sdl.JOYBUTTONDOWN:   "joybuttondown",
+	sdl.JOYBUTTONUP:     "joybuttonup",
+}
+
+//eventName gets an event type's name
+func eventName(eventType uint32) string {
+	return eventNames[eventType]
+}
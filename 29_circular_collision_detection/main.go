@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"log"
 
@@ -27,9 +28,15 @@ var (
 
 	//Scene textures
 	gDotTexture LTexture
+
+	//Input recording options
+	recordPath = flag.String("record", "", "record input to `file`")
+	replayPath = flag.String("replay", "", "replay input from `file`")
 )
 
 func main() {
+	flag.Parse()
+
 	//Start up SDL and create window
 	if err := initSDl(); err != nil {
 		log.Fatalf("Could not init SDL: %v\n", err)
@@ -40,6 +47,27 @@ func main() {
 		log.Fatalf("Could not load media: %v\n", err)
 	}
 
+	//Where the frame's input comes from
+	var source EventSource = LiveEventSource{}
+	var recorder *EventRecorder
+	var replayer *EventReplayer
+
+	if *replayPath != "" {
+		//Play back a recording
+		var err error
+		if replayer, err = LoadEventReplayer(*replayPath); err != nil {
+			log.Fatalf("Could not load recording: %v\n", err)
+		}
+		source = replayer
+	} else if *recordPath != "" {
+		//Record live input, nothing in this lesson is random so the seed isn't used
+		var err error
+		if recorder, err = NewEventRecorder(source, *recordPath, 0); err != nil {
+			log.Fatalf("Could not start recording: %v\n", err)
+		}
+		source = recorder
+	}
+
 	//Main loop flag
 	var quit bool
 
@@ -57,8 +85,17 @@ func main() {
 
 	//While application is running
 	for !quit {
+		//Only let the user close the window while replaying
+		if replayer != nil {
+			for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+				if e.GetType() == sdl.QUIT {
+					quit = true
+				}
+			}
+		}
+
 		//Handle events on queue
-		for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+		for e = source.PollEvent(); e != nil; e = source.PollEvent() {
 			//User requests quit
 			if e.GetType() == sdl.QUIT {
 				quit = true
@@ -103,6 +140,21 @@ func main() {
 
 		//Update screen
 		gRenderer.Present()
+
+		//Go to next frame
+		source.NextFrame()
+
+		//Stop once the whole recording was played
+		if replayer != nil && replayer.IsFinished() {
+			quit = true
+		}
+	}
+
+	//Finish recording
+	if recorder != nil {
+		if err := recorder.Close(); err != nil {
+			log.Fatalf("Could not save recording: %v\n", err)
+		}
 	}
 
 	//Free resources and close SDL
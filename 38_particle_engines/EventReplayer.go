@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//EventReplayer plays back a recording file frame by frame
+type EventReplayer struct {
+	//Recorded events by frame
+	mFrames map[uint64][]sdl.Event
+
+	//Recorded random seed
+	mSeed int64
+
+	//Frame being played and the one the recording ended on
+	mFrame    uint64
+	mEndFrame uint64
+
+	//Next event to hand out in the current frame
+	mNextEvent int
+}
+
+//LoadEventReplayer reads a whole recording file
+func LoadEventReplayer(path string) (*EventReplayer, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open recording file: %v", err)
+	}
+	defer file.Close()
+
+	er := &EventReplayer{mFrames: make(map[uint64][]sdl.Event)}
+
+	scanner := bufio.NewScanner(file)
+
+	//Check header
+	if !scanner.Scan() || scanner.Text() != recordingHeader {
+		return nil, fmt.Errorf("%s is not an input recording", path)
+	}
+
+	for line := 2; scanner.Scan(); line++ {
+		fields := strings.SplitN(scanner.Text(), " ", 3)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("error loading recording: missing fields at line %d", line)
+		}
+
+		//Random seed line
+		if fields[0] == "seed" {
+			if er.mSeed, err = strconv.ParseInt(fields[1], 10, 64); err != nil {
+				return nil, fmt.Errorf("error loading recording: invalid seed at line %d", line)
+			}
+			continue
+		}
+
+		frame, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("error loading recording: invalid frame at line %d", line)
+		}
+
+		//End of recording marker
+		if fields[1] == "end" {
+			er.mEndFrame = frame
+			continue
+		}
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("error loading recording: missing fields at line %d", line)
+		}
+
+		e, err := parseEvent(fields[1], fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("error loading recording at line %d: %v", line, err)
+		}
+		er.mFrames[frame] = append(er.mFrames[frame], e)
+
+		//Recordings cut short by a crash end after their last event
+		if frame >= er.mEndFrame {
+			er.mEndFrame = frame + 1
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read recording file: %v", err)
+	}
+
+	return er, nil
+}
+
+//PollEvent gets the next recorded event of the current frame
+func (er *EventReplayer) PollEvent() sdl.Event {
+	events := er.mFrames[er.mFrame]
+	if er.mNextEvent >= len(events) {
+		return nil
+	}
+
+	e := events[er.mNextEvent]
+	er.mNextEvent++
+	return e
+}
+
+//NextFrame moves on to the next recorded frame
+func (er *EventReplayer) NextFrame() {
+	er.mFrame++
+	er.mNextEvent = 0
+}
+
+//PushFrame pushes the current frame's events into the SDL event queue
+//so a loop polling SDL directly receives them, live input still gets through too
+func (er *EventReplayer) PushFrame() error {
+	for e := er.PollEvent(); e != nil; e = er.PollEvent() {
+		if _, err := sdl.PushEvent(e); err != nil {
+			return fmt.Errorf("could not push recorded event: %v", err)
+		}
+	}
+
+	return nil
+}
+
+//Seed gets the random seed the recording was made with
+func (er *EventReplayer) Seed() int64 {
+	return er.mSeed
+}
+
+//Frame gets the frame being played
+func (er *EventReplayer) Frame() uint64 {
+	return er.mFrame
+}
+
+//IsFinished checks if every recorded frame was played
+func (er *EventReplayer) IsFinished() bool {
+	return er.mFrame >= er.mEndFrame
+}
+
+//parseEvent builds an event from its recording line
+func parseEvent(name, data string) (sdl.Event, error) {
+	var err error
+	var e sdl.Event
+
+	switch name {
+	case "quit":
+		q := &sdl.QuitEvent{Type: sdl.QUIT}
+		_, err = fmt.Sscan(data, &q.Timestamp)
+		e = q
+	case "keydown", "keyup":
+		k := &sdl.KeyboardEvent{Type: sdl.KEYDOWN}
+		if name == "keyup" {
+			k.Type = sdl.KEYUP
+		}
+		_, err = fmt.Sscan(data, &k.Timestamp, &k.WindowID, &k.State, &k.Repeat,
+			&k.Keysym.Scancode, &k.Keysym.Sym, &k.Keysym.Mod)
+		e = k
+	case "textinput":
+		t := &sdl.TextInputEvent{Type: sdl.TEXTINPUT}
+		var text string
+		_, err = fmt.Sscanf(data, "%d %d %q", &t.Timestamp, &t.WindowID, &text)
+
+		//Keep the null terminator
+		copy(t.Text[:len(t.Text)-1], text)
+		e = t
+	case "mousemotion":
+		m := &sdl.MouseMotionEvent{Type: sdl.MOUSEMOTION}
+		_, err = fmt.Sscan(data, &m.Timestamp, &m.WindowID, &m.Which, &m.State,
+			&m.X, &m.Y, &m.XRel, &m.YRel)
+		e = m
+	case "mousebuttondown", "mousebuttonup":
+		m := &sdl.MouseButtonEvent{Type: sdl.MOUSEBUTTONDOWN}
+		if name == "mousebuttonup" {
+			m.Type = sdl.MOUSEBUTTONUP
+		}
+		_, err = fmt.Sscan(data, &m.Timestamp, &m.WindowID, &m.Which, &m.Button, &m.State,
+			&m.Clicks, &m.X, &m.Y)
+		e = m
+	case "mousewheel":
+		m := &sdl.MouseWheelEvent{Type: sdl.MOUSEWHEEL}
+		_, err = fmt.Sscan(data, &m.Timestamp, &m.WindowID, &m.Which, &m.X, &m.Y, &m.Direction)
+		e = m
+	case "joyaxis":
+		j := &sdl.JoyAxisEvent{Type: sdl.JOYAXISMOTION}
+		_, err = fmt.Sscan(data, &j.Timestamp, &j.Which, &j.Axis, &j.Value)
+		e = j
+	case "joyhat":
+		j := &sdl.JoyHatEvent{Type: sdl.JOYHATMOTION}
+		_, err = fmt.Sscan(data, &j.Timestamp, &j.Which, &j.Hat, &j.Value)
+		e = j
+	case "joybuttondown", "joybuttonup":
+		j := &sdl.JoyButtonEvent{Type: sdl.JOYBUTTONDOWN}
+		if name == "joybuttonup" {
+			j.Type = sdl.JOYBUTTONUP
+		}
+		_, err = fmt.Sscan(data, &j.Timestamp, &j.Which, &j.Button, &j.State)
+		e = j
+	default:
+		return nil, fmt.Errorf("unknown event %q", name)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s event: %v", name, err)
+	}
+
+	return e, nil
+}
@@ -2,7 +2,6 @@ package main
 
 import (
 	"fmt"
-	"math/rand"
 
 	"github.com/veandco/go-sdl2/sdl"
 )
@@ -28,14 +27,14 @@ func NewParticle(x, y int32) *Particle {
 	p := &Particle{}
 
 	//Set offsets
-	p.mPosX = x - 5 + rand.Int31n(25)
-	p.mPosY = y - 5 + rand.Int31n(25)
+	p.mPosX = x - 5 + gRandom.Int31n(25)
+	p.mPosY = y - 5 + gRandom.Int31n(25)
 
 	//Initialize animation
-	p.mFrame = rand.Intn(5)
+	p.mFrame = gRandom.Intn(5)
 
 	//Set type
-	switch rand.Intn(3) {
+	switch gRandom.Intn(3) {
 	case 0:
 		p.mTexture = &gRedTexture
 		break
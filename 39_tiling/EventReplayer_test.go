@@ -0,0 +1,46 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//replay plays a recording through the dot the way the main loop does and gets where it ended
+func replay(t *testing.T, path string) sdl.Rect {
+	t.Helper()
+
+	replayer, err := LoadEventReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tiles := make([]*Tile, totalTiles)
+	if err := setTiles(tiles); err != nil {
+		t.Fatalf("could not load tiles: %v", err)
+	}
+
+	dot := NewDot()
+	for !replayer.IsFinished() {
+		for e := replayer.PollEvent(); e != nil; e = replayer.PollEvent() {
+			dot.HandleEvent(e)
+		}
+		dot.Move(tiles)
+		replayer.NextFrame()
+	}
+
+	return dot.mBox
+}
+
+func TestReplayMovesDot(t *testing.T) {
+	//Same recording and level as 45_input_recording_and_replay, so the dot ends in the same place
+	box := replay(t, filepath.Join("testdata", "walk.rec"))
+	if want := (sdl.Rect{X: 200, Y: 140, W: DotWidth, H: DotHeight}); box != want {
+		t.Errorf("dot ended at %v, want %v", box, want)
+	}
+
+	if again := replay(t, filepath.Join("testdata", "walk.rec")); again != box {
+		t.Errorf("second replay ended at %v, first at %v", again, box)
+	}
+}
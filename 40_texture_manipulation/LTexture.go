@@ -17,6 +17,9 @@ type LTexture struct {
 	//Image dimensions
 	mWidth  int32
 	mHeight int32
+
+	//Pixel format the texture was created with
+	mFormat uint32
 }
 
 //NewLTexture initializes variables
@@ -74,6 +77,7 @@ func (lt *LTexture) LoadFromFile(path string) error {
 	//Get image dimensions
 	lt.mWidth = formattedSurface.W
 	lt.mHeight = formattedSurface.H
+	lt.mFormat = pixelFormat
 
 	//Get rid of old formatted surface
 	formattedSurface.Free()
@@ -216,6 +220,16 @@ func (lt *LTexture) UnlockTexture() error {
 	return nil
 }
 
+//Pixels gets typed access to the pixels of a locked texture
+func (lt *LTexture) Pixels() (*PixelAccessor, error) {
+	//Texture is not locked
+	if lt.mPixels == nil {
+		return nil, fmt.Errorf("texture is not locked")
+	}
+
+	return NewPixelAccessor(lt.mPixels, lt.mPitch, lt.mWidth, lt.mHeight, lt.mFormat)
+}
+
 //MWidth gets image width
 func (lt *LTexture) MWidth() int32 {
 	return lt.mWidth
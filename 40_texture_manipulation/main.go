@@ -1,8 +1,8 @@
 package main
 
 import (
-	"encoding/binary"
 	"fmt"
+	"image/color"
 	"log"
 
 	"github.com/veandco/go-sdl2/img"
@@ -140,46 +140,30 @@ func loadMedia() error {
 		return fmt.Errorf("unable to lock foo texture: %v", err)
 	}
 
-	//Allocate format from window
-	format, err := gWindow.GetPixelFormat()
+	//Get pixel data in the texture's format
+	pixels, err := gFooTexture.Pixels()
 	if err != nil {
-		return fmt.Errorf("could not get pixel format from window: %v", err)
+		return fmt.Errorf("could not access foo texture pixels: %v", err)
 	}
 
-	mappingFormat, err := sdl.AllocFormat(uint(format))
-	if err != nil {
-		return fmt.Errorf("could not get mapping format: %v", err)
-	}
-
-	//Get pixel data
-	bytePixels := gFooTexture.MPixels()
-	pixels := make([]uint32, len(bytePixels)/4)
-	for i := range pixels {
-		//Assuming little endian
-		pixels[i] = uint32(binary.LittleEndian.Uint32(bytePixels[i*4 : (i+1)*4]))
-	}
-
-	pixelCount := (gFooTexture.MPitch() / 4) * int(gFooTexture.MHeight())
-
 	//Map colors
-	colorKey := sdl.MapRGB(mappingFormat, 0, 255, 255)
-	transparent := sdl.MapRGBA(mappingFormat, 255, 255, 255, 0)
+	colorKey := color.RGBA{R: 0, G: 255, B: 255, A: 255}
+	transparent := color.RGBA{R: 255, G: 255, B: 255, A: 0}
 
 	//Color key pixels
-	for i := 0; i < pixelCount; i++ {
-		if pixels[i] == colorKey {
-			binary.LittleEndian.PutUint32(bytePixels[i*4:(i*4)+4], transparent)
+	pixels.EachRow(func(y int, row []color.RGBA) {
+		for x := range row {
+			if row[x] == colorKey {
+				row[x] = transparent
+			}
 		}
-	}
+	})
 
 	//Unlock texture
 	if err = gFooTexture.UnlockTexture(); err != nil {
 		return fmt.Errorf("could not unlock foo texture: %v", err)
 	}
 
-	//Free format
-	mappingFormat.Free()
-
 	return nil
 }
 
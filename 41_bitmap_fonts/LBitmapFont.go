@@ -26,8 +26,15 @@ func (bmf *LBitmapFont) BuildFont(bitmap *LTexture) error {
 		return fmt.Errorf("unable to lock bitmap font texture: %v", err)
 	}
 
+	//Get typed pixel access
+	pixels, err := bitmap.Pixels()
+	if err != nil {
+		bitmap.UnlockTexture()
+		return fmt.Errorf("unable to access bitmap font pixels: %v", err)
+	}
+
 	//Set the background color
-	bgColor := bitmap.GetPixel32(0, 0)
+	bgColor := pixels.At(0, 0)
 
 	//Set the cell dimensions
 	cellW := bitmap.MWidth() / 16
@@ -62,7 +69,7 @@ func (bmf *LBitmapFont) BuildFont(bitmap *LTexture) error {
 					pY := (cellH * rows) + pRow
 
 					//If a non colorkey is found
-					if bitmap.GetPixel32(int(pX), int(pY)) != bgColor {
+					if pixels.At(int(pX), int(pY)) != bgColor {
 						//Set the x offset
 						bmf.mChars[currentChar].X = pX
 
@@ -83,7 +90,7 @@ func (bmf *LBitmapFont) BuildFont(bitmap *LTexture) error {
 					pY := (cellH * rows) + pRowW
 
 					//If a non colorkey pixel is found
-					if bitmap.GetPixel32(int(pX), int(pY)) != bgColor {
+					if pixels.At(int(pX), int(pY)) != bgColor {
 						//Set the width
 						bmf.mChars[currentChar].W = (pX - bmf.mChars[currentChar].X) + 1
 
@@ -104,7 +111,7 @@ func (bmf *LBitmapFont) BuildFont(bitmap *LTexture) error {
 					pY := (cellH * rows) + pRow
 
 					//If a non colorkey pixel is found
-					if bitmap.GetPixel32(int(pX), int(pY)) != bgColor {
+					if pixels.At(int(pX), int(pY)) != bgColor {
 						//If a new top is found
 						if pRow < top {
 							top = pRow
@@ -128,7 +135,7 @@ func (bmf *LBitmapFont) BuildFont(bitmap *LTexture) error {
 						pY := (cellH * rows) + pRow
 
 						//If a non colorkey pixel is found
-						if bitmap.GetPixel32(int(pX), int(pY)) != bgColor {
+						if pixels.At(int(pX), int(pY)) != bgColor {
 							//Bottom of A is found
 							baseA = pRow
 
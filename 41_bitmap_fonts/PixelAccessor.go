@@ -0,0 +1,238 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"math/bits"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//PixelAccessor reads and writes locked texture memory in the texture's own pixel format
+//Colors are straight alpha like sdl.Color, not premultiplied
+//Coordinates outside the texture read as transparent black and are ignored on write, like Go's image types
+type PixelAccessor struct {
+	//Locked pixels and the length of a row in bytes
+	mPixels []byte
+	mPitch  int
+
+	//Texture dimensions
+	mWidth  int
+	mHeight int
+
+	//SDL pixel format enum
+	mFormat uint32
+
+	//Bit offsets of each channel in a packed pixel
+	mShiftR uint
+	mShiftG uint
+	mShiftB uint
+	mShiftA uint
+
+	//Formats without alpha read as opaque
+	mHasAlpha bool
+}
+
+//NewPixelAccessor wraps locked pixels, supporting 32 bit formats with 8 bits per channel
+func NewPixelAccessor(pixels []byte, pitch int, width, height int32, format uint32) (*PixelAccessor, error) {
+	if sdl.BytesPerPixel(format) != 4 {
+		return nil, fmt.Errorf("unsupported pixel format %v: not 4 bytes per pixel", sdl.GetPixelFormatName(uint(format)))
+	}
+
+	_, rmask, gmask, bmask, amask, err := sdl.PixelFormatEnumToMasks(uint(format))
+	if err != nil {
+		return nil, fmt.Errorf("could not get pixel format masks: %v", err)
+	}
+
+	pa := &PixelAccessor{mPixels: pixels, mPitch: pitch, mWidth: int(width), mHeight: int(height), mFormat: format}
+
+	//Every channel has to be a whole byte
+	var ok bool
+	if pa.mShiftR, ok = channelShift(rmask); !ok {
+		return nil, fmt.Errorf("unsupported pixel format %v: red is not 8 bits", sdl.GetPixelFormatName(uint(format)))
+	}
+	if pa.mShiftG, ok = channelShift(gmask); !ok {
+		return nil, fmt.Errorf("unsupported pixel format %v: green is not 8 bits", sdl.GetPixelFormatName(uint(format)))
+	}
+	if pa.mShiftB, ok = channelShift(bmask); !ok {
+		return nil, fmt.Errorf("unsupported pixel format %v: blue is not 8 bits", sdl.GetPixelFormatName(uint(format)))
+	}
+	if amask != 0 {
+		if pa.mShiftA, ok = channelShift(amask); !ok {
+			return nil, fmt.Errorf("unsupported pixel format %v: alpha is not 8 bits", sdl.GetPixelFormatName(uint(format)))
+		}
+		pa.mHasAlpha = true
+	}
+
+	//Make sure every row fits
+	if width < 0 || height < 0 || pitch < int(width)*4 {
+		return nil, fmt.Errorf("invalid pixel dimensions %dx%d with pitch %d", width, height, pitch)
+	}
+	if height > 0 && len(pixels) < (int(height)-1)*pitch+int(width)*4 {
+		return nil, fmt.Errorf("pixel buffer of %d bytes is too small for %dx%d with pitch %d", len(pixels), width, height, pitch)
+	}
+
+	return pa, nil
+}
+
+//Bounds gets the texture area
+func (pa *PixelAccessor) Bounds() image.Rectangle {
+	return image.Rect(0, 0, pa.mWidth, pa.mHeight)
+}
+
+//Format gets the SDL pixel format enum
+func (pa *PixelAccessor) Format() uint32 {
+	return pa.mFormat
+}
+
+//InBounds checks if a pixel is inside the texture
+func (pa *PixelAccessor) InBounds(x, y int) bool {
+	return x >= 0 && y >= 0 && x < pa.mWidth && y < pa.mHeight
+}
+
+//At gets a pixel's color
+func (pa *PixelAccessor) At(x, y int) color.RGBA {
+	if !pa.InBounds(x, y) {
+		return color.RGBA{}
+	}
+
+	return pa.unpack(pa.at32(x, y))
+}
+
+//Set sets a pixel's color
+func (pa *PixelAccessor) Set(x, y int, c color.RGBA) {
+	if pa.InBounds(x, y) {
+		pa.set32(x, y, pa.pack(c))
+	}
+}
+
+//At32 gets a pixel packed in the texture's format
+func (pa *PixelAccessor) At32(x, y int) uint32 {
+	if !pa.InBounds(x, y) {
+		return 0
+	}
+
+	return pa.at32(x, y)
+}
+
+//Set32 sets a pixel packed in the texture's format
+func (pa *PixelAccessor) Set32(x, y int, pixel uint32) {
+	if pa.InBounds(x, y) {
+		pa.set32(x, y, pixel)
+	}
+}
+
+//ReadRow decodes a row of pixels into a slice at least as long as the texture is wide
+func (pa *PixelAccessor) ReadRow(y int, row []color.RGBA) error {
+	if y < 0 || y >= pa.mHeight {
+		return fmt.Errorf("row %d is outside the texture", y)
+	}
+	if len(row) < pa.mWidth {
+		return fmt.Errorf("row of %d pixels is shorter than the texture width %d", len(row), pa.mWidth)
+	}
+
+	for x := 0; x < pa.mWidth; x++ {
+		row[x] = pa.unpack(pa.at32(x, y))
+	}
+
+	return nil
+}
+
+//WriteRow encodes a row of pixels from a slice at least as long as the texture is wide
+func (pa *PixelAccessor) WriteRow(y int, row []color.RGBA) error {
+	if y < 0 || y >= pa.mHeight {
+		return fmt.Errorf("row %d is outside the texture", y)
+	}
+	if len(row) < pa.mWidth {
+		return fmt.Errorf("row of %d pixels is shorter than the texture width %d", len(row), pa.mWidth)
+	}
+
+	for x := 0; x < pa.mWidth; x++ {
+		pa.set32(x, y, pa.pack(row[x]))
+	}
+
+	return nil
+}
+
+//EachRow calls a function with every row decoded and writes back whatever it changes
+func (pa *PixelAccessor) EachRow(edit func(y int, row []color.RGBA)) {
+	row := make([]color.RGBA, pa.mWidth)
+	for y := 0; y < pa.mHeight; y++ {
+		pa.ReadRow(y, row)
+		edit(y, row)
+		pa.WriteRow(y, row)
+	}
+}
+
+//ToImage copies the pixels into a Go image
+func (pa *PixelAccessor) ToImage() *image.NRGBA {
+	img := image.NewNRGBA(pa.Bounds())
+
+	for y := 0; y < pa.mHeight; y++ {
+		for x := 0; x < pa.mWidth; x++ {
+			c := pa.unpack(pa.at32(x, y))
+			i := img.PixOffset(x, y)
+			img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = c.R, c.G, c.B, c.A
+		}
+	}
+
+	return img
+}
+
+//FromImage copies a Go image into the pixels, aligning top left corners and clipping to the texture
+func (pa *PixelAccessor) FromImage(img image.Image) {
+	bounds := img.Bounds()
+	area := pa.Bounds().Intersect(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			c := color.NRGBAModel.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.NRGBA)
+			pa.set32(x, y, pa.pack(color.RGBA{R: c.R, G: c.G, B: c.B, A: c.A}))
+		}
+	}
+}
+
+//at32 reads a packed pixel, SDL stores them in native byte order
+func (pa *PixelAccessor) at32(x, y int) uint32 {
+	i := y*pa.mPitch + x*4
+	return binary.NativeEndian.Uint32(pa.mPixels[i : i+4])
+}
+
+//set32 writes a packed pixel
+func (pa *PixelAccessor) set32(x, y int, pixel uint32) {
+	i := y*pa.mPitch + x*4
+	binary.NativeEndian.PutUint32(pa.mPixels[i:i+4], pixel)
+}
+
+//unpack splits a packed pixel into channels
+func (pa *PixelAccessor) unpack(pixel uint32) color.RGBA {
+	c := color.RGBA{
+		R: uint8(pixel >> pa.mShiftR),
+		G: uint8(pixel >> pa.mShiftG),
+		B: uint8(pixel >> pa.mShiftB),
+		A: 255,
+	}
+	if pa.mHasAlpha {
+		c.A = uint8(pixel >> pa.mShiftA)
+	}
+
+	return c
+}
+
+//pack joins channels into a packed pixel
+func (pa *PixelAccessor) pack(c color.RGBA) uint32 {
+	pixel := uint32(c.R)<<pa.mShiftR | uint32(c.G)<<pa.mShiftG | uint32(c.B)<<pa.mShiftB
+	if pa.mHasAlpha {
+		pixel |= uint32(c.A) << pa.mShiftA
+	}
+
+	return pixel
+}
+
+//channelShift gets the bit offset of a channel mask, which has to be 8 contiguous bits
+func channelShift(mask uint32) (uint, bool) {
+	shift := uint(bits.TrailingZeros32(mask))
+	return shift, mask != 0 && mask>>shift == 0xFF
+}
@@ -2,23 +2,72 @@ package main
 
 import (
 	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/veandco/go-sdl2/img"
 
 	"github.com/veandco/go-sdl2/sdl"
 )
 
-//DataStream is a test animation stream
+//Number of reusable frame buffers passed between the producer and the render thread
+const streamBufferCount = 3
+
+//StreamFrame is a reusable buffer holding one frame of the stream
+type StreamFrame struct {
+	//Frame pixels in the stream's format
+	Pixels []byte
+
+	//Position of the frame in the stream
+	Sequence uint64
+}
+
+//DataStreamStats is a snapshot of the stream counters
+type DataStreamStats struct {
+	//Frames the producer finished
+	Produced uint64
+
+	//Frames the render thread took
+	Consumed uint64
+
+	//Finished frames skipped because a newer one was ready
+	Dropped uint64
+
+	//Times the producer had to wait for the render thread to give a buffer back
+	Stalls uint64
+
+	//Frames ready to be taken
+	Queued int
+}
+
+//DataStream is a test animation stream generated on a goroutine
+//Only Acquire and Release are called from the render thread, the producer never touches SDL
 type DataStream struct {
-	//Internal data
-	mImages       [4]*sdl.Surface
-	mCurrentImage int
-	mDelayFrames  int
+	//Source images copied out of their surfaces so the producer can read them freely
+	mImages [4][]byte
+
+	//Time between frames
+	mFrameInterval time.Duration
+
+	//Ring of buffers, free ones go to the producer and finished ones come back in order
+	mFree  chan *StreamFrame
+	mReady chan *StreamFrame
+
+	//Tells the producer to stop and waits for it to finish
+	mStop chan struct{}
+	mDone chan struct{}
+
+	//Counters shared between the threads
+	mProduced atomic.Uint64
+	mConsumed atomic.Uint64
+	mDropped  atomic.Uint64
+	mStalls   atomic.Uint64
 }
 
 //NewDataStream initializes internals
 func NewDataStream() *DataStream {
-	return &DataStream{mDelayFrames: 4}
+	//Advance every 4 frames at 60 frames per second like before
+	return &DataStream{mFrameInterval: time.Second * 4 / 60}
 }
 
 //LoadMedia loads initial data
@@ -32,34 +81,139 @@ func (ds *DataStream) LoadMedia() error {
 			return fmt.Errorf("unable to load %s: %v", path, err)
 		}
 
-		if ds.mImages[i], err = loadedSurface.ConvertFormat(sdl.PIXELFORMAT_RGBA8888, 0); err != nil {
+		formattedSurface, err := loadedSurface.ConvertFormat(sdl.PIXELFORMAT_RGBA8888, 0)
+		loadedSurface.Free()
+		if err != nil {
 			return fmt.Errorf("could not convert surface format: %v", err)
 		}
 
-		loadedSurface.Free()
+		//Keep a copy of the pixels and let go of the surface
+		ds.mImages[i] = append([]byte(nil), formattedSurface.Pixels()...)
+		formattedSurface.Free()
+	}
+
+	return nil
+}
+
+//Start begins producing frames on a goroutine
+func (ds *DataStream) Start() error {
+	if ds.mStop != nil {
+		return fmt.Errorf("data stream already started")
 	}
 
+	//Every buffer starts out free
+	ds.mFree = make(chan *StreamFrame, streamBufferCount)
+	ds.mReady = make(chan *StreamFrame, streamBufferCount)
+	for i := 0; i < streamBufferCount; i++ {
+		ds.mFree <- &StreamFrame{Pixels: make([]byte, len(ds.mImages[0]))}
+	}
+
+	ds.mStop = make(chan struct{}, 1)
+	ds.mDone = make(chan struct{}, 1)
+	go ds.produce()
+
 	return nil
 }
 
+//Stop stops the producer and waits for it to exit
+func (ds *DataStream) Stop() {
+	if ds.mStop == nil {
+		return
+	}
+
+	ds.mStop <- struct{}{}
+	<-ds.mDone
+	ds.mStop = nil
+}
+
 //Free is the deallocator
 func (ds *DataStream) Free() {
+	ds.Stop()
+
 	for i := 0; i < 4; i++ {
-		ds.mImages[i].Free()
+		ds.mImages[i] = nil
 	}
 }
 
-//GetBuffer gets current frame data
-func (ds *DataStream) GetBuffer() []byte {
-	ds.mDelayFrames--
-	if ds.mDelayFrames == 0 {
-		ds.mCurrentImage++
-		ds.mDelayFrames = 4
+//Acquire takes the newest finished frame, or nil if there is nothing new
+//Older finished frames are dropped, every acquired frame has to be released
+func (ds *DataStream) Acquire() *StreamFrame {
+	var newest *StreamFrame
+
+	for {
+		select {
+		case frame := <-ds.mReady:
+			//A newer frame makes the one before it stale
+			if newest != nil {
+				ds.mDropped.Add(1)
+				ds.mFree <- newest
+			}
+			newest = frame
+			break
+		default:
+			if newest != nil {
+				ds.mConsumed.Add(1)
+			}
+			return newest
+		}
 	}
+}
 
-	if ds.mCurrentImage == 4 {
-		ds.mCurrentImage = 0
+//Release gives a frame's buffer back to the producer
+func (ds *DataStream) Release(frame *StreamFrame) {
+	ds.mFree <- frame
+}
+
+//Stats gets the stream counters
+func (ds *DataStream) Stats() DataStreamStats {
+	return DataStreamStats{
+		Produced: ds.mProduced.Load(),
+		Consumed: ds.mConsumed.Load(),
+		Dropped:  ds.mDropped.Load(),
+		Stalls:   ds.mStalls.Load(),
+		Queued:   len(ds.mReady),
 	}
+}
 
-	return ds.mImages[ds.mCurrentImage].Pixels()
+//produce runs on its own goroutine writing a frame into a free buffer every interval
+func (ds *DataStream) produce() {
+	defer func() { ds.mDone <- struct{}{} }()
+
+	ticker := time.NewTicker(ds.mFrameInterval)
+	defer ticker.Stop()
+
+	var sequence uint64
+	for {
+		//Get a buffer, waiting for the render thread if they are all in use
+		var frame *StreamFrame
+		select {
+		case frame = <-ds.mFree:
+			break
+		default:
+			ds.mStalls.Add(1)
+			select {
+			case frame = <-ds.mFree:
+				break
+			case <-ds.mStop:
+				return
+			}
+		}
+
+		//Generate the frame
+		copy(frame.Pixels, ds.mImages[sequence%4])
+		frame.Sequence = sequence
+		sequence++
+
+		//Hand it over, there is always room since there are only as many buffers as slots
+		ds.mProduced.Add(1)
+		ds.mReady <- frame
+
+		//Wait for the next frame time
+		select {
+		case <-ticker.C:
+			break
+		case <-ds.mStop:
+			return
+		}
+	}
 }
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+)
+
+//newTestDataStream creates a fast stream of small frames filled in memory, no image files needed
+func newTestDataStream() *DataStream {
+	ds := NewDataStream()
+	ds.mFrameInterval = time.Millisecond
+	for i := range ds.mImages {
+		ds.mImages[i] = bytes.Repeat([]byte{byte(i + 1)}, 64*4)
+	}
+
+	return ds
+}
+
+//checkFrame fails the test if a frame was torn or came out of order
+func checkFrame(t *testing.T, ds *DataStream, frame *StreamFrame, last *int64) {
+	if !bytes.Equal(frame.Pixels, ds.mImages[frame.Sequence%4]) {
+		t.Errorf("frame %d doesn't match image %d", frame.Sequence, frame.Sequence%4)
+	}
+	if int64(frame.Sequence) <= *last {
+		t.Errorf("frame %d came after frame %d", frame.Sequence, *last)
+	}
+	*last = int64(frame.Sequence)
+}
+
+//checkCounts fails the test unless every produced frame was consumed, dropped or is still queued
+func checkCounts(t *testing.T, stats DataStreamStats) {
+	t.Helper()
+
+	if stats.Produced != stats.Consumed+stats.Dropped+uint64(stats.Queued) {
+		t.Errorf("produced %d frames but consumed %d, dropped %d and queued %d",
+			stats.Produced, stats.Consumed, stats.Dropped, stats.Queued)
+	}
+}
+
+//stopWithin stops the stream, failing the test if the producer doesn't exit in time
+func stopWithin(t *testing.T, ds *DataStream, timeout time.Duration) {
+	t.Helper()
+
+	stopped := make(chan struct{})
+	go func() {
+		ds.Stop()
+		stopped <- struct{}{}
+	}()
+
+	select {
+	case <-stopped:
+		break
+	case <-time.After(timeout):
+		t.Fatalf("Stop didn't return within %v", timeout)
+	}
+}
+
+//Run these with go test -race to check the producer and render thread share nothing unguarded
+func TestDataStreamAcquireRelease(t *testing.T) {
+	ds := newTestDataStream()
+	if err := ds.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := ds.Start(); err == nil {
+		t.Errorf("second Start should fail")
+	}
+
+	//Render thread taking frames at its own pace while another reads the stats
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				ds.Stats()
+				time.Sleep(100 * time.Microsecond)
+			}
+		}
+	}()
+
+	last := int64(-1)
+	deadline := time.Now().Add(300 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		if frame := ds.Acquire(); frame != nil {
+			checkFrame(t, ds, frame, &last)
+
+			//Hold on to it for a bit like a texture upload
+			time.Sleep(time.Duration(frame.Sequence%3) * time.Millisecond)
+			ds.Release(frame)
+		}
+	}
+
+	done <- struct{}{}
+	wg.Wait()
+	stopWithin(t, ds, time.Second)
+
+	stats := ds.Stats()
+	if stats.Consumed == 0 {
+		t.Errorf("no frames were consumed")
+	}
+	checkCounts(t, stats)
+}
+
+func TestDataStreamStopWhileStalled(t *testing.T) {
+	ds := newTestDataStream()
+	if err := ds.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	//Take every buffer and don't give them back
+	var held []*StreamFrame
+	last := int64(-1)
+	deadline := time.Now().Add(time.Second)
+	for len(held) < streamBufferCount && time.Now().Before(deadline) {
+		if frame := ds.Acquire(); frame != nil {
+			checkFrame(t, ds, frame, &last)
+			held = append(held, frame)
+		}
+	}
+	if len(held) != streamBufferCount {
+		t.Fatalf("acquired %d buffers, want %d", len(held), streamBufferCount)
+	}
+
+	//The producer ends up waiting for a buffer
+	for ds.Stats().Stalls == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("producer never stalled")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	stopWithin(t, ds, time.Second)
+	checkCounts(t, ds.Stats())
+
+	for _, frame := range held {
+		ds.Release(frame)
+	}
+}
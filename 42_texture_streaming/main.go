@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"log"
+	"runtime"
 
 	"github.com/veandco/go-sdl2/img"
 	"github.com/veandco/go-sdl2/sdl"
@@ -32,6 +33,11 @@ var (
 	gDataStream = NewDataStream()
 )
 
+func init() {
+	//SDL calls have to stay on the main OS thread while the stream is produced on other goroutines
+	runtime.LockOSThread()
+}
+
 func main() {
 	//Start up SDL and create window
 	if err := initSDl(); err != nil {
@@ -46,6 +52,9 @@ func main() {
 	//Main loop flag
 	var quit bool
 
+	//Caption shown in the window title
+	var caption string
+
 	//Event handler
 	var e sdl.Event
 
@@ -69,13 +78,25 @@ func main() {
 			log.Fatalf("could not clear renderer: %v", err)
 		}
 
-		//Copy frame from buffer
-		if err := gStreamingTexture.LockTexture(); err != nil {
-			log.Fatal(err)
+		//Copy new frame from buffer
+		if frame := gDataStream.Acquire(); frame != nil {
+			if err := gStreamingTexture.LockTexture(); err != nil {
+				log.Fatal(err)
+			}
+			gStreamingTexture.CopyPixels(frame.Pixels)
+			if err := gStreamingTexture.UnlockTexture(); err != nil {
+				log.Fatal(err)
+			}
+			gDataStream.Release(frame)
 		}
-		gStreamingTexture.CopyPixels(gDataStream.GetBuffer())
-		if err := gStreamingTexture.UnlockTexture(); err != nil {
-			log.Fatal(err)
+
+		//Show stream counters
+		stats := gDataStream.Stats()
+		newCaption := fmt.Sprintf("SDL Tutorial - produced %d, consumed %d, dropped %d, stalls %d, queued %d",
+			stats.Produced, stats.Consumed, stats.Dropped, stats.Stalls, stats.Queued)
+		if newCaption != caption {
+			caption = newCaption
+			gWindow.SetTitle(caption)
 		}
 
 		//Render frame
@@ -149,6 +170,11 @@ func loadMedia() error {
 		return fmt.Errorf("unable to load data stream: %v", err)
 	}
 
+	//Start producing frames
+	if err := gDataStream.Start(); err != nil {
+		return fmt.Errorf("unable to start data stream: %v", err)
+	}
+
 	return nil
 }
 
@@ -1,8 +1,8 @@
 package main
 
 import (
-	"encoding/binary"
 	"fmt"
+	"image/color"
 
 	"github.com/veandco/go-sdl2/img"
 	"github.com/veandco/go-sdl2/sdl"
@@ -22,6 +22,9 @@ type LTexture struct {
 	//Image dimensions
 	mWidth  int32
 	mHeight int32
+
+	//Pixel format the texture was created with
+	mFormat uint32
 }
 
 //NewLTexture initializes variables
@@ -45,12 +48,14 @@ func (lt *LTexture) LoadFromFile(path string) error {
 	if err != nil {
 		return fmt.Errorf("could not load image %v! SDL_image Error: %v", path, err)
 	}
+	defer loadedSurface.Free()
 
 	//Convert surface to display format
 	formattedSurface, err := loadedSurface.ConvertFormat(sdl.PIXELFORMAT_ABGR8888, 0)
 	if err != nil {
 		return fmt.Errorf("could not convert surface to display format: %v", err)
 	}
+	defer formattedSurface.Free()
 
 	//Create blank streamable texture
 	newTexture, err = gRenderer.CreateTexture(sdl.PIXELFORMAT_ABGR8888, sdl.TEXTUREACCESS_STREAMING,
@@ -61,12 +66,14 @@ func (lt *LTexture) LoadFromFile(path string) error {
 
 	//Enable blending on texture
 	if err := newTexture.SetBlendMode(sdl.BLENDMODE_BLEND); err != nil {
+		newTexture.Destroy()
 		return fmt.Errorf("could not set texture's blend mode: %v", err)
 	}
 
 	//Lock texture for manipulation
 	lt.mPixels, lt.mPitch, err = newTexture.Lock(&formattedSurface.ClipRect)
 	if err != nil {
+		newTexture.Destroy()
 		return fmt.Errorf("could not lock texture: %v", err)
 	}
 
@@ -76,38 +83,34 @@ func (lt *LTexture) LoadFromFile(path string) error {
 	//Get image dimensions
 	lt.mWidth = formattedSurface.W
 	lt.mHeight = formattedSurface.H
+	lt.mFormat = sdl.PIXELFORMAT_ABGR8888
 
 	//Get pixel data in editable format
-	bytePixels := lt.mPixels
-	pixels := make([]uint32, len(bytePixels)/4)
-	for i := range pixels {
-		//Assuming little endian
-		pixels[i] = uint32(binary.LittleEndian.Uint32(bytePixels[i*4 : (i+1)*4]))
+	pixels, err := lt.Pixels()
+	if err != nil {
+		newTexture.Unlock()
+		lt.mPixels = nil
+		newTexture.Destroy()
+		return fmt.Errorf("could not access texture pixels: %v", err)
 	}
 
-	pixelCount := (lt.mPitch / 4) * int(lt.mHeight)
-
 	//Map colors
-	colorKey := sdl.MapRGB(formattedSurface.Format, 0, 255, 255)
-	transparent := sdl.MapRGBA(formattedSurface.Format, 0, 255, 0, 0)
+	colorKey := color.RGBA{R: 0, G: 255, B: 255, A: 255}
+	transparent := color.RGBA{R: 0, G: 255, B: 0, A: 0}
 
 	//Color key pixels
-	for i := 0; i < pixelCount; i++ {
-		if pixels[i] == colorKey {
-			binary.LittleEndian.PutUint32(bytePixels[i*4:(i*4)+4], transparent)
+	pixels.EachRow(func(y int, row []color.RGBA) {
+		for x := range row {
+			if row[x] == colorKey {
+				row[x] = transparent
+			}
 		}
-	}
+	})
 
 	//Unlock texture to update
 	newTexture.Unlock()
 	lt.mPixels = nil
 
-	//Get rid of old formatted surface
-	formattedSurface.Free()
-
-	//Get rid of old loaded surface
-	loadedSurface.Free()
-
 	//Return no errors
 	lt.mTexture = newTexture
 	return nil
@@ -243,6 +246,16 @@ func (lt *LTexture) UnlockTexture() error {
 	return nil
 }
 
+//Pixels gets typed access to the pixels of a locked texture
+func (lt *LTexture) Pixels() (*PixelAccessor, error) {
+	//Texture is not locked
+	if lt.mPixels == nil {
+		return nil, fmt.Errorf("texture is not locked")
+	}
+
+	return NewPixelAccessor(lt.mPixels, lt.mPitch, lt.mWidth, lt.mHeight, lt.mFormat)
+}
+
 //CreateBlank creates blank texture
 func (lt *LTexture) CreateBlank(width, height int32, access TextureAccess) error {
 	var err error
@@ -255,6 +268,7 @@ func (lt *LTexture) CreateBlank(width, height int32, access TextureAccess) error
 
 	lt.mWidth = width
 	lt.mHeight = height
+	lt.mFormat = sdl.PIXELFORMAT_RGBA8888
 
 	return nil
 }
@@ -296,11 +310,3 @@ func (lt *LTexture) MPixels() []byte {
 func (lt *LTexture) MPitch() int {
 	return lt.mPitch
 }
-
-//GetPixel32 at exact (x,y) coordinate
-func (lt *LTexture) GetPixel32(x, y int) uint32 {
-	//Convert the pixel to 32 bit
-	position := y*lt.mPitch + x*4
-
-	return uint32(binary.LittleEndian.Uint32(lt.mPixels[position : position+4]))
-}
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	//DotWidth is the dot's width
+	DotWidth = 20
+	//DotHeight is the dot's height
+	DotHeight = 20
+
+	//DotVel is the maximum axis velocity of the dot
+	DotVel = 20
+)
+
+//Dot is the dot that will move around on the screen
+type Dot struct {
+	mPosX, mPosY int32
+	mVelX, mVelY int32
+}
+
+//HandleInput reads the movement actions and adjusts the dot's velocity
+func (d *Dot) HandleInput(input *InputMap) {
+	//Set the velocity from the action values
+	d.mVelX = int32(input.Value("move_x") * DotVel)
+	d.mVelY = int32(input.Value("move_y") * DotVel)
+}
+
+//Center puts the dot in the middle of the screen
+func (d *Dot) Center() {
+	d.mPosX = (screenWitdh - DotWidth) / 2
+	d.mPosY = (screenHeight - DotHeight) / 2
+}
+
+//Move moves the dot
+func (d *Dot) Move() {
+	//Move the dot left or right
+	d.mPosX += d.mVelX
+
+	//If the dot went too far to the left or right
+	if d.mPosX < 0 || d.mPosX+DotWidth > screenWitdh {
+		//Move back
+		d.mPosX -= d.mVelX
+	}
+
+	//Move the dot up or down
+	d.mPosY += d.mVelY
+
+	//If the dot went too far up or down
+	if d.mPosY < 0 || d.mPosY+DotHeight > screenHeight {
+		//Move back
+		d.mPosY -= d.mVelY
+	}
+}
+
+//Render shows the dot on the screen
+func (d *Dot) Render() error {
+	//Show the dot
+	err := gDotTexture.Render(d.mPosX, d.mPosY, nil, 0, nil, sdl.FLIP_NONE)
+	if err != nil {
+		return fmt.Errorf("could not render dot: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,491 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//BindingType is the kind of input a binding listens to
+type BindingType int
+
+//Binding type enum
+const (
+	bindingKey BindingType = iota
+	bindingMouseButton
+	bindingJoyButton
+	bindingJoyAxis
+	bindingJoyAxisNegative
+	bindingJoyAxisPositive
+)
+
+const (
+	//Analog joystick dead zone
+	joystickDeadZone = 8000
+
+	//Value an action has to reach to count as held
+	actionPressThreshold = 0.5
+)
+
+//Names used for the binding types in bindings files
+var bindingTypeNames = map[BindingType]string{
+	bindingKey:             "key",
+	bindingMouseButton:     "mouse",
+	bindingJoyButton:       "joybutton",
+	bindingJoyAxis:         "joyaxis",
+	bindingJoyAxisNegative: "joyaxis-",
+	bindingJoyAxisPositive: "joyaxis+",
+}
+
+//Binding ties a single device input to an action
+type Binding struct {
+	//What kind of input this is
+	mType BindingType
+
+	//Scancode, mouse button, joystick button or joystick axis
+	mCode int
+
+	//Joystick instance id the button or axis belongs to
+	mJoystick sdl.JoystickID
+
+	//How much the input adds to the action's value
+	mScale float64
+}
+
+//KeyBinding binds a keyboard key
+func KeyBinding(scancode sdl.Scancode, scale float64) Binding {
+	return Binding{mType: bindingKey, mCode: int(scancode), mScale: scale}
+}
+
+//MouseButtonBinding binds a mouse button
+func MouseButtonBinding(button uint8, scale float64) Binding {
+	return Binding{mType: bindingMouseButton, mCode: int(button), mScale: scale}
+}
+
+//JoyButtonBinding binds a button of a joystick
+func JoyButtonBinding(joystick sdl.JoystickID, button uint8, scale float64) Binding {
+	return Binding{mType: bindingJoyButton, mCode: int(button), mJoystick: joystick, mScale: scale}
+}
+
+//JoyAxisBinding binds a whole joystick axis, pushing it left or up gives negative values
+func JoyAxisBinding(joystick sdl.JoystickID, axis uint8, scale float64) Binding {
+	return Binding{mType: bindingJoyAxis, mCode: int(axis), mJoystick: joystick, mScale: scale}
+}
+
+//JoyAxisSideBinding binds one side of a joystick axis, pushing it that way from the center gives 0 to 1
+func JoyAxisSideBinding(joystick sdl.JoystickID, axis uint8, negative bool, scale float64) Binding {
+	b := Binding{mType: bindingJoyAxisPositive, mCode: int(axis), mJoystick: joystick, mScale: scale}
+	if negative {
+		b.mType = bindingJoyAxisNegative
+	}
+
+	return b
+}
+
+//isJoystick checks if the binding belongs to a joystick
+func (b Binding) isJoystick() bool {
+	return b.mType == bindingJoyButton || b.mType == bindingJoyAxis ||
+		b.mType == bindingJoyAxisNegative || b.mType == bindingJoyAxisPositive
+}
+
+//pushes checks if the binding can move the action's value in a direction
+func (b Binding) pushes(positive bool) bool {
+	//A whole axis goes both ways
+	if b.mType == bindingJoyAxis {
+		return b.mScale != 0
+	}
+
+	if positive {
+		return b.mScale > 0
+	}
+	return b.mScale < 0
+}
+
+//String returns the binding in bindings file format
+func (b Binding) String() string {
+	code := strconv.Itoa(b.mCode)
+	if b.mType == bindingKey {
+		code = strings.Replace(sdl.GetScancodeName(sdl.Scancode(b.mCode)), " ", "_", -1)
+	} else if b.isJoystick() {
+		code = fmt.Sprintf("%d:%d", b.mJoystick, b.mCode)
+	}
+
+	return fmt.Sprintf("%s %s %s", bindingTypeNames[b.mType], code,
+		strconv.FormatFloat(b.mScale, 'g', -1, 64))
+}
+
+//inputAction holds an action's bindings and its state for the current frame
+type inputAction struct {
+	mBindings []Binding
+
+	//Current and last frame state
+	mValue    float64
+	mHeld     bool
+	mPrevHeld bool
+}
+
+//joyInput is a button or axis of one joystick
+type joyInput struct {
+	mJoystick sdl.JoystickID
+	mCode     uint8
+}
+
+//InputMap maps game actions to keys, mouse buttons and joystick inputs
+type InputMap struct {
+	//Actions by name
+	mActions map[string]*inputAction
+
+	//Raw device state gathered from events
+	mKeys         map[sdl.Scancode]bool
+	mMouseButtons map[uint8]bool
+	mJoyButtons   map[joyInput]bool
+	mJoyAxes      map[joyInput]int16
+
+	//Action waiting for its next input to be rebound
+	mRebindAction string
+	mRebindScale  float64
+}
+
+//NewInputMap initializes an empty input map
+func NewInputMap() *InputMap {
+	return &InputMap{
+		mActions:      make(map[string]*inputAction),
+		mKeys:         make(map[sdl.Scancode]bool),
+		mMouseButtons: make(map[uint8]bool),
+		mJoyButtons:   make(map[joyInput]bool),
+		mJoyAxes:      make(map[joyInput]int16),
+	}
+}
+
+//Bind adds a binding to an action, creating the action if needed
+func (im *InputMap) Bind(action string, b Binding) {
+	a, ok := im.mActions[action]
+	if !ok {
+		a = &inputAction{}
+		im.mActions[action] = a
+	}
+
+	a.mBindings = append(a.mBindings, b)
+}
+
+//Unbind removes all bindings of an action
+func (im *InputMap) Unbind(action string) {
+	if a, ok := im.mActions[action]; ok {
+		a.mBindings = nil
+	}
+}
+
+//Clear removes every action
+func (im *InputMap) Clear() {
+	im.mActions = make(map[string]*inputAction)
+}
+
+//Bindings returns a copy of an action's bindings
+func (im *InputMap) Bindings(action string) []Binding {
+	a, ok := im.mActions[action]
+	if !ok {
+		return nil
+	}
+
+	return append([]Binding(nil), a.mBindings...)
+}
+
+//StartRebind makes the next key, button or axis push replace the action's
+//bindings that point in the same direction as scale
+func (im *InputMap) StartRebind(action string, scale float64) {
+	im.mRebindAction = action
+	im.mRebindScale = scale
+}
+
+//CancelRebind stops waiting for a rebind input
+func (im *InputMap) CancelRebind() {
+	im.mRebindAction = ""
+}
+
+//IsRebinding checks if an action is waiting for its new input
+func (im *InputMap) IsRebinding() bool {
+	return im.mRebindAction != ""
+}
+
+//HandleEvent records device state from an event
+func (im *InputMap) HandleEvent(e sdl.Event) {
+	switch e.GetType() {
+	case sdl.KEYDOWN:
+		kEvent := e.(*sdl.KeyboardEvent)
+		if kEvent.Repeat == 0 && im.rebind(KeyBinding(kEvent.Keysym.Scancode, im.mRebindScale)) {
+			return
+		}
+		im.mKeys[kEvent.Keysym.Scancode] = true
+		break
+	case sdl.KEYUP:
+		im.mKeys[(e.(*sdl.KeyboardEvent)).Keysym.Scancode] = false
+		break
+	case sdl.MOUSEBUTTONDOWN:
+		mEvent := e.(*sdl.MouseButtonEvent)
+		if im.rebind(MouseButtonBinding(mEvent.Button, im.mRebindScale)) {
+			return
+		}
+		im.mMouseButtons[mEvent.Button] = true
+		break
+	case sdl.MOUSEBUTTONUP:
+		im.mMouseButtons[(e.(*sdl.MouseButtonEvent)).Button] = false
+		break
+	case sdl.JOYBUTTONDOWN:
+		jEvent := e.(*sdl.JoyButtonEvent)
+		if im.rebind(JoyButtonBinding(jEvent.Which, jEvent.Button, im.mRebindScale)) {
+			return
+		}
+		im.mJoyButtons[joyInput{jEvent.Which, jEvent.Button}] = true
+		break
+	case sdl.JOYBUTTONUP:
+		jEvent := e.(*sdl.JoyButtonEvent)
+		im.mJoyButtons[joyInput{jEvent.Which, jEvent.Button}] = false
+		break
+	case sdl.JOYAXISMOTION:
+		aEvent := e.(*sdl.JoyAxisEvent)
+
+		//Only a clear push past the dead zone can be used to rebind, and only that side of the axis gets bound
+		if aEvent.Value < -joystickDeadZone || aEvent.Value > joystickDeadZone {
+			if im.rebind(JoyAxisSideBinding(aEvent.Which, aEvent.Axis, aEvent.Value < 0, im.mRebindScale)) {
+				return
+			}
+		}
+		im.mJoyAxes[joyInput{aEvent.Which, aEvent.Axis}] = aEvent.Value
+		break
+	}
+}
+
+//rebind replaces the pending action's bindings with b
+func (im *InputMap) rebind(b Binding) bool {
+	if im.mRebindAction == "" {
+		return false
+	}
+
+	//Keep the bindings that can't push the action the way it's being rebound
+	var kept []Binding
+	for _, old := range im.Bindings(im.mRebindAction) {
+		if !old.pushes(im.mRebindScale > 0) {
+			kept = append(kept, old)
+		}
+	}
+
+	im.Unbind(im.mRebindAction)
+	for _, old := range kept {
+		im.Bind(im.mRebindAction, old)
+	}
+	im.Bind(im.mRebindAction, b)
+
+	im.mRebindAction = ""
+	return true
+}
+
+//Update calculates every action's state for this frame
+//It must be called once per frame after the events were handled
+func (im *InputMap) Update() {
+	for _, a := range im.mActions {
+		a.mPrevHeld = a.mHeld
+		a.mValue = 0
+
+		//Add up bound inputs
+		for _, b := range a.mBindings {
+			a.mValue += im.bindingValue(b)
+		}
+
+		//Keep value in range
+		a.mValue = math.Max(-1, math.Min(1, a.mValue))
+		a.mHeld = math.Abs(a.mValue) >= actionPressThreshold
+	}
+}
+
+//bindingValue gets the scaled value of a single binding
+func (im *InputMap) bindingValue(b Binding) float64 {
+	var down bool
+
+	switch b.mType {
+	case bindingKey:
+		down = im.mKeys[sdl.Scancode(b.mCode)]
+		break
+	case bindingMouseButton:
+		down = im.mMouseButtons[uint8(b.mCode)]
+		break
+	case bindingJoyButton:
+		down = im.mJoyButtons[joyInput{b.mJoystick, uint8(b.mCode)}]
+		break
+	case bindingJoyAxis, bindingJoyAxisNegative, bindingJoyAxisPositive:
+		value := im.mJoyAxes[joyInput{b.mJoystick, uint8(b.mCode)}]
+
+		//Inside dead zone
+		if value > -joystickDeadZone && value < joystickDeadZone {
+			return 0
+		}
+
+		axis := math.Max(-1, float64(value)/math.MaxInt16)
+
+		//Sides only count when pushed their way
+		if b.mType == bindingJoyAxisNegative {
+			return b.mScale * math.Max(0, -axis)
+		}
+		if b.mType == bindingJoyAxisPositive {
+			return b.mScale * math.Max(0, axis)
+		}
+
+		return b.mScale * axis
+	}
+
+	if down {
+		return b.mScale
+	}
+	return 0
+}
+
+//Pressed checks if the action started being held this frame
+func (im *InputMap) Pressed(action string) bool {
+	a, ok := im.mActions[action]
+	return ok && a.mHeld && !a.mPrevHeld
+}
+
+//Held checks if the action is being held
+func (im *InputMap) Held(action string) bool {
+	a, ok := im.mActions[action]
+	return ok && a.mHeld
+}
+
+//Released checks if the action stopped being held this frame
+func (im *InputMap) Released(action string) bool {
+	a, ok := im.mActions[action]
+	return ok && !a.mHeld && a.mPrevHeld
+}
+
+//Value gets the action's value between -1 and 1
+func (im *InputMap) Value(action string) float64 {
+	a, ok := im.mActions[action]
+	if !ok {
+		return 0
+	}
+
+	return a.mValue
+}
+
+//SaveToFile writes every binding to a bindings file
+func (im *InputMap) SaveToFile(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("could not create bindings file: %v", err)
+	}
+	defer file.Close()
+
+	//Write actions in a stable order
+	names := make([]string, 0, len(im.mActions))
+	for name := range im.mActions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	writer := bufio.NewWriter(file)
+	fmt.Fprintln(writer, "#action type code scale")
+	for _, name := range names {
+		for _, b := range im.mActions[name].mBindings {
+			fmt.Fprintf(writer, "%s %v\n", name, b)
+		}
+	}
+
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("could not write bindings file: %v", err)
+	}
+
+	return nil
+}
+
+//LoadFromFile replaces every binding with the ones in a bindings file
+func (im *InputMap) LoadFromFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("could not open bindings file: %v", err)
+	}
+	defer file.Close()
+
+	//Parse everything before touching the current bindings
+	loaded := NewInputMap()
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+
+		//Skip blank lines and comments
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) != 4 {
+			return fmt.Errorf("error loading bindings: expected 4 fields at line %d", line)
+		}
+
+		b, err := parseBinding(fields[1], fields[2], fields[3])
+		if err != nil {
+			return fmt.Errorf("error loading bindings at line %d: %v", line, err)
+		}
+		loaded.Bind(fields[0], b)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("could not read bindings file: %v", err)
+	}
+
+	im.mActions = loaded.mActions
+	return nil
+}
+
+//parseBinding builds a binding from its bindings file fields
+func parseBinding(typeName, code, scale string) (Binding, error) {
+	var b Binding
+
+	//Find the binding type
+	found := false
+	for t, name := range bindingTypeNames {
+		if name == typeName {
+			b.mType = t
+			found = true
+			break
+		}
+	}
+	if !found {
+		return b, fmt.Errorf("unknown binding type %q", typeName)
+	}
+
+	//Keys are stored by name, joystick inputs as joystick:number and mouse buttons by number
+	if b.mType == bindingKey {
+		scancode := sdl.GetScancodeFromName(strings.Replace(code, "_", " ", -1))
+		if scancode == sdl.SCANCODE_UNKNOWN {
+			return b, fmt.Errorf("unknown key %q", code)
+		}
+		b.mCode = int(scancode)
+	} else {
+		//Older files only have the number, which was the first joystick
+		if b.isJoystick() {
+			if parts := strings.SplitN(code, ":", 2); len(parts) == 2 {
+				joystick, err := strconv.ParseInt(parts[0], 10, 32)
+				if err != nil {
+					return b, fmt.Errorf("invalid joystick %q", parts[0])
+				}
+				b.mJoystick = sdl.JoystickID(joystick)
+				code = parts[1]
+			}
+		}
+
+		number, err := strconv.ParseUint(code, 10, 8)
+		if err != nil {
+			return b, fmt.Errorf("invalid %s code %q", typeName, code)
+		}
+		b.mCode = int(number)
+	}
+
+	var err error
+	if b.mScale, err = strconv.ParseFloat(scale, 64); err != nil {
+		return b, fmt.Errorf("invalid scale %q", scale)
+	}
+
+	return b, nil
+}
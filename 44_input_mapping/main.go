@@ -0,0 +1,248 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/veandco/go-sdl2/img"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	//Screen dimension constants
+	screenWitdh  = 640
+	screenHeight = 480
+
+	//File the bindings are kept in
+	bindingsPath = "bindings.cfg"
+)
+
+var (
+	//The window we'll be rendering to
+	gWindow *sdl.Window
+
+	//The window renderer
+	gRenderer *sdl.Renderer
+
+	//Scene textures
+	gDotTexture LTexture
+
+	//Game controller 1 handler
+	gGameController *sdl.Joystick
+
+	//Action bindings
+	gInput *InputMap
+)
+
+func main() {
+	//Start up SDL and create window
+	if err := initSDl(); err != nil {
+		log.Fatalf("Could not init SDL: %v\n", err)
+	}
+
+	//Load media
+	if err := loadMedia(); err != nil {
+		log.Fatalf("Could not load media: %v\n", err)
+	}
+
+	//Main loop flag
+	var quit bool
+
+	//Event handler
+	var e sdl.Event
+
+	//The dot that will be moving around on the screen
+	var dot Dot
+	dot.Center()
+
+	//While application is running
+	for !quit {
+		//Handle events on queue
+		for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+			//User requests quit
+			if e.GetType() == sdl.QUIT {
+				quit = true
+			}
+
+			//Record input for the actions
+			gInput.HandleEvent(e)
+		}
+
+		//Calculate this frame's actions
+		gInput.Update()
+
+		//Wait for the new center input
+		if gInput.Pressed("rebind") && !gInput.IsRebinding() {
+			fmt.Println("Press a key, mouse button or joystick button to bind to center...")
+			gInput.StartRebind("center", 1)
+		}
+
+		//Put the dot back in the middle
+		if gInput.Pressed("center") {
+			dot.Center()
+		}
+
+		//Move the dot
+		dot.HandleInput(gInput)
+		dot.Move()
+
+		//Clear screen
+		err := gRenderer.SetDrawColor(255, 255, 255, 255)
+		if err != nil {
+			log.Fatalf("could not set draw color for renderer: %v", err)
+		}
+		err = gRenderer.Clear()
+		if err != nil {
+			log.Fatalf("could not clear renderer: %v", err)
+		}
+
+		//Render objects
+		err = dot.Render()
+		if err != nil {
+			log.Fatalf("%v\n", err)
+		}
+
+		//Update screen
+		gRenderer.Present()
+	}
+
+	//Free resources and close SDL
+	if err := close(); err != nil {
+		log.Fatalf("Could not close SDL! SDL Error: %v\n", err)
+	}
+}
+
+func initSDl() error {
+	//Local error declaration
+	var err error
+
+	//Initialize SDL
+	if err := sdl.Init(sdl.INIT_VIDEO | sdl.INIT_JOYSTICK); err != nil {
+		return fmt.Errorf("SDL could not initialize! SDL_ERROR: %v", err)
+	}
+
+	//Set texture filtering to linear
+	if !sdl.SetHint(sdl.HINT_RENDER_SCALE_QUALITY, "1") {
+		fmt.Printf("Warning: Linear texture filtering not enabled!")
+	}
+
+	//Check for joysticks
+	if sdl.NumJoysticks() < 1 {
+		fmt.Printf("Warning: No joysticks connected!\n")
+	} else {
+		//Load joystick
+		gGameController = sdl.JoystickOpen(0)
+		if gGameController == nil {
+			fmt.Printf("Waning: Unable to open game controller! SDL_Error: %v\n", sdl.GetError())
+		}
+	}
+
+	//Create Window
+	gWindow, err = sdl.CreateWindow("SDL Tutorial", sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED,
+		screenWitdh, screenHeight, sdl.WINDOW_SHOWN)
+	if err != nil {
+		return fmt.Errorf("Window could not be created! SDL_Error: %v", err)
+	}
+
+	//Create vsynced renderer for window
+	if gRenderer, err = sdl.CreateRenderer(gWindow, -1, sdl.RENDERER_ACCELERATED|sdl.RENDERER_PRESENTVSYNC); err != nil {
+		return fmt.Errorf("Renderer could not be created! SDL Error: %v", err)
+	}
+
+	//Initialize renderer color
+	gRenderer.SetDrawColor(255, 255, 255, 255)
+
+	//Initialize PNG loading
+	imgFlags := img.INIT_PNG
+	if (img.Init(imgFlags) & imgFlags) == 0 {
+		return fmt.Errorf("SDL_image could not initialize! SDL_image Error: %v", img.GetError())
+	}
+
+	return nil
+}
+
+func loadMedia() error {
+	var err error
+
+	//Load dot texture
+	err = gDotTexture.LoadFromFile("dot.bmp")
+	if err != nil {
+		return fmt.Errorf("Failed to load dot texture: %v", err)
+	}
+
+	//Load saved bindings
+	gInput = NewInputMap()
+	if err = gInput.LoadFromFile(bindingsPath); err != nil {
+		fmt.Printf("Warning: unable to load bindings, using defaults: %v\n", err)
+
+		//Joystick inputs are told apart by the joystick they come from
+		var joystick sdl.JoystickID
+		if gGameController != nil {
+			joystick = gGameController.InstanceID()
+		}
+		setDefaultBindings(gInput, joystick)
+	}
+
+	return nil
+}
+
+func close() error {
+	//Save bindings
+	if err := gInput.SaveToFile(bindingsPath); err != nil {
+		fmt.Printf("Warning: could not save bindings: %v\n", err)
+	}
+
+	//Free loaded images
+	if err := gDotTexture.Free(); err != nil {
+		return fmt.Errorf("could not free dot texture: %v", err)
+	}
+
+	//Close game controller
+	if gGameController != nil {
+		gGameController.Close()
+		gGameController = nil
+	}
+
+	//Destroy window
+	if err := gRenderer.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy global renderer: %v", err)
+	}
+	if err := gWindow.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy window: %v", err)
+	}
+	gWindow = nil
+	gRenderer = nil
+
+	//Quit SDL Subsystems
+	img.Quit()
+	sdl.Quit()
+
+	return nil
+}
+
+//Binds the actions to arrow keys, WASD and a joystick
+func setDefaultBindings(input *InputMap, joystick sdl.JoystickID) {
+	input.Clear()
+
+	//Horizontal movement
+	input.Bind("move_x", KeyBinding(sdl.SCANCODE_RIGHT, 1))
+	input.Bind("move_x", KeyBinding(sdl.SCANCODE_LEFT, -1))
+	input.Bind("move_x", KeyBinding(sdl.SCANCODE_D, 1))
+	input.Bind("move_x", KeyBinding(sdl.SCANCODE_A, -1))
+	input.Bind("move_x", JoyAxisBinding(joystick, 0, 1))
+
+	//Vertical movement
+	input.Bind("move_y", KeyBinding(sdl.SCANCODE_DOWN, 1))
+	input.Bind("move_y", KeyBinding(sdl.SCANCODE_UP, -1))
+	input.Bind("move_y", KeyBinding(sdl.SCANCODE_S, 1))
+	input.Bind("move_y", KeyBinding(sdl.SCANCODE_W, -1))
+	input.Bind("move_y", JoyAxisBinding(joystick, 1, 1))
+
+	//Recenter the dot
+	input.Bind("center", KeyBinding(sdl.SCANCODE_SPACE, 1))
+	input.Bind("center", MouseButtonBinding(sdl.BUTTON_RIGHT, 1))
+	input.Bind("center", JoyButtonBinding(joystick, 0, 1))
+
+	//Start rebinding center
+	input.Bind("rebind", KeyBinding(sdl.SCANCODE_F1, 1))
+}
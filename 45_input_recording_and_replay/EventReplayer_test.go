@@ -0,0 +1,178 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//loadTestTiles reads the lesson's level without loading any textures
+func loadTestTiles(t *testing.T) []*Tile {
+	t.Helper()
+
+	tiles := make([]*Tile, totalTiles)
+	if err := setTiles(tiles); err != nil {
+		t.Fatalf("could not load tiles: %v", err)
+	}
+
+	return tiles
+}
+
+//replay plays a recording through the lesson's frame update and gets where the dot ended
+func replay(t *testing.T, path string) (sdl.Rect, uint64) {
+	t.Helper()
+
+	replayer, err := LoadEventReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tiles := loadTestTiles(t)
+	dot := NewDot()
+	for !replayer.IsFinished() {
+		if updateFrame(replayer, dot, tiles) {
+			t.Fatalf("recording quit at frame %d", replayer.Frame())
+		}
+		replayer.NextFrame()
+	}
+
+	return dot.MBox(), replayer.Frame()
+}
+
+func TestReplayMovesDot(t *testing.T) {
+	box, frames := replay(t, filepath.Join("testdata", "walk.rec"))
+
+	//Right for 30 frames, down until the wall at the top of the third row, then left for 10 frames
+	//The repeated key down is ignored, so the dot doesn't speed up
+	want := sdl.Rect{X: 200, Y: 140, W: DotWidth, H: DotHeight}
+	if box != want {
+		t.Errorf("dot ended at %v, want %v", box, want)
+	}
+	if frames != 120 {
+		t.Errorf("replay lasted %d frames, want 120", frames)
+	}
+
+	//Playing it again ends in exactly the same place
+	if again, _ := replay(t, filepath.Join("testdata", "walk.rec")); again != box {
+		t.Errorf("second replay ended at %v, first at %v", again, box)
+	}
+}
+
+//scriptedSource hands out a fixed list of events per frame
+type scriptedSource struct {
+	mFrames [][]sdl.Event
+	mFrame  int
+	mNext   int
+}
+
+//PollEvent gets the next event of the current frame
+func (ss *scriptedSource) PollEvent() sdl.Event {
+	if ss.mFrame >= len(ss.mFrames) || ss.mNext >= len(ss.mFrames[ss.mFrame]) {
+		return nil
+	}
+
+	e := ss.mFrames[ss.mFrame][ss.mNext]
+	ss.mNext++
+	return e
+}
+
+//NextFrame moves on to the next frame
+func (ss *scriptedSource) NextFrame() {
+	ss.mFrame++
+	ss.mNext = 0
+}
+
+func TestRecordingRoundTrip(t *testing.T) {
+	text := &sdl.TextInputEvent{Type: sdl.TEXTINPUT, Timestamp: 40, WindowID: 1}
+	copy(text.Text[:], "\"quoted\" é")
+
+	frames := [][]sdl.Event{
+		{
+			&sdl.KeyboardEvent{Type: sdl.KEYDOWN, Timestamp: 10, WindowID: 1, State: sdl.PRESSED,
+				Keysym: sdl.Keysym{Scancode: sdl.SCANCODE_RIGHT, Sym: sdl.K_RIGHT, Mod: sdl.KMOD_LSHIFT}},
+			&sdl.MouseMotionEvent{Type: sdl.MOUSEMOTION, Timestamp: 11, WindowID: 1, X: 5, Y: 6, XRel: -1, YRel: 2},
+		},
+		{},
+		{
+			text,
+			&sdl.MouseButtonEvent{Type: sdl.MOUSEBUTTONUP, Timestamp: 41, WindowID: 1, Button: sdl.BUTTON_LEFT, Clicks: 2, X: 7, Y: 8},
+			&sdl.MouseWheelEvent{Type: sdl.MOUSEWHEEL, Timestamp: 42, WindowID: 1, X: 0, Y: -3},
+			&sdl.JoyAxisEvent{Type: sdl.JOYAXISMOTION, Timestamp: 43, Which: 0, Axis: 1, Value: -32768},
+			&sdl.JoyHatEvent{Type: sdl.JOYHATMOTION, Timestamp: 44, Which: 0, Hat: 0, Value: sdl.HAT_LEFTUP},
+			&sdl.JoyButtonEvent{Type: sdl.JOYBUTTONDOWN, Timestamp: 45, Which: 0, Button: 3, State: sdl.PRESSED},
+		},
+		{
+			&sdl.QuitEvent{Type: sdl.QUIT, Timestamp: 60},
+		},
+	}
+
+	//Record every frame
+	path := filepath.Join(t.TempDir(), "roundtrip.rec")
+	recorder, err := NewEventRecorder(&scriptedSource{mFrames: frames}, path, -7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range frames {
+		for e := recorder.PollEvent(); e != nil; e = recorder.PollEvent() {
+		}
+		recorder.NextFrame()
+	}
+	if err := recorder.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	//Play it back
+	replayer, err := LoadEventReplayer(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayer.Seed() != -7 {
+		t.Errorf("seed = %d, want -7", replayer.Seed())
+	}
+
+	for frame, events := range frames {
+		if replayer.IsFinished() {
+			t.Fatalf("replay finished early at frame %d", frame)
+		}
+
+		for i, want := range events {
+			got := replayer.PollEvent()
+			if got == nil {
+				t.Fatalf("frame %d: missing event %d", frame, i)
+			}
+			if formatEvent(got) != formatEvent(want) {
+				t.Errorf("frame %d event %d = %q, want %q", frame, i, formatEvent(got), formatEvent(want))
+			}
+		}
+		if extra := replayer.PollEvent(); extra != nil {
+			t.Errorf("frame %d: unexpected event %q", frame, formatEvent(extra))
+		}
+
+		replayer.NextFrame()
+	}
+	if !replayer.IsFinished() {
+		t.Errorf("replay didn't finish after %d frames", len(frames))
+	}
+}
+
+func TestLoadEventReplayerErrors(t *testing.T) {
+	cases := map[string]string{
+		"no header":     "0 keydown 1 1 1 0 79 1073741903 0\n",
+		"bad frame":     recordingHeader + "\nx keydown 1 1 1 0 79 1073741903 0\n",
+		"bad seed":      recordingHeader + "\nseed x\n",
+		"unknown event": recordingHeader + "\n0 teleport 1 2 3\n",
+		"short event":   recordingHeader + "\n0 keydown 1 1\n",
+	}
+
+	for name, contents := range cases {
+		path := filepath.Join(t.TempDir(), "bad.rec")
+		if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadEventReplayer(path); err == nil {
+			t.Errorf("%v: want an error", name)
+		}
+	}
+}
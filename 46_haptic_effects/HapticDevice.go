@@ -0,0 +1,192 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//HapticDevice is a force feedback backend
+type HapticDevice interface {
+	//Capabilities gets the supported HAPTIC_* flags
+	Capabilities() uint32
+
+	//SupportsRumble checks if simple rumble can be played
+	SupportsRumble() bool
+
+	//NewEffect uploads an effect and returns its id
+	NewEffect(effect sdl.HapticEffect) (int, error)
+
+	//RunEffect plays an uploaded effect
+	RunEffect(id int, iterations uint32) error
+
+	//StopEffect stops a playing effect
+	StopEffect(id int) error
+
+	//DestroyEffect frees an uploaded effect
+	DestroyEffect(id int)
+
+	//RumblePlay plays a simple rumble
+	RumblePlay(strength float32, length uint32) error
+
+	//RumbleStop stops the simple rumble
+	RumbleStop() error
+
+	//Close frees the device
+	Close()
+}
+
+//sdlHapticDevice plays effects on a SDL haptic device
+type sdlHapticDevice struct {
+	//The actual haptic device
+	mHaptic *sdl.Haptic
+
+	//Device capabilities
+	mCapabilities uint32
+	mRumble       bool
+}
+
+//OpenHapticDevice opens the joystick's haptic device, falling back to a
+//device that does nothing when the joystick has no force feedback
+func OpenHapticDevice(joystick *sdl.Joystick) HapticDevice {
+	//No joystick to get haptics from
+	if joystick == nil {
+		return nullHapticDevice{}
+	}
+
+	//Get joystick haptic device
+	haptic, err := sdl.HapticOpenFromJoystick(joystick)
+	if err != nil {
+		fmt.Printf("Warning: Controller does not support haptics! SDL Error: %v\n", err)
+		return nullHapticDevice{}
+	}
+
+	hd := &sdlHapticDevice{mHaptic: haptic}
+
+	//Query supported effects
+	if hd.mCapabilities, err = haptic.Query(); err != nil {
+		fmt.Printf("Warning: Unable to query haptic capabilities! SDL Error: %v\n", err)
+	}
+
+	//Initialize rumble
+	if supported, err := haptic.RumbleSupported(); err == nil && supported {
+		if err = haptic.RumbleInit(); err != nil {
+			fmt.Printf("Warning: Unable to initialize rumble! SDL Error: %v\n", err)
+		} else {
+			hd.mRumble = true
+		}
+	}
+
+	return hd
+}
+
+//Capabilities gets the supported HAPTIC_* flags
+func (hd *sdlHapticDevice) Capabilities() uint32 {
+	return hd.mCapabilities
+}
+
+//SupportsRumble checks if simple rumble was initialized
+func (hd *sdlHapticDevice) SupportsRumble() bool {
+	return hd.mRumble
+}
+
+//NewEffect uploads an effect to the device
+func (hd *sdlHapticDevice) NewEffect(effect sdl.HapticEffect) (int, error) {
+	id, err := hd.mHaptic.NewEffect(effect)
+	if err != nil {
+		return -1, fmt.Errorf("could not upload haptic effect: %v", err)
+	}
+
+	return id, nil
+}
+
+//RunEffect plays an uploaded effect
+func (hd *sdlHapticDevice) RunEffect(id int, iterations uint32) error {
+	if err := hd.mHaptic.RunEffect(id, iterations); err != nil {
+		return fmt.Errorf("could not run haptic effect: %v", err)
+	}
+
+	return nil
+}
+
+//StopEffect stops a playing effect
+func (hd *sdlHapticDevice) StopEffect(id int) error {
+	if err := hd.mHaptic.StopEffect(id); err != nil {
+		return fmt.Errorf("could not stop haptic effect: %v", err)
+	}
+
+	return nil
+}
+
+//DestroyEffect frees an uploaded effect
+func (hd *sdlHapticDevice) DestroyEffect(id int) {
+	hd.mHaptic.DestroyEffect(id)
+}
+
+//RumblePlay plays a simple rumble
+func (hd *sdlHapticDevice) RumblePlay(strength float32, length uint32) error {
+	if err := hd.mHaptic.RumblePlay(strength, length); err != nil {
+		return fmt.Errorf("could not play rumble: %v", err)
+	}
+
+	return nil
+}
+
+//RumbleStop stops the simple rumble
+func (hd *sdlHapticDevice) RumbleStop() error {
+	if err := hd.mHaptic.RumbleStop(); err != nil {
+		return fmt.Errorf("could not stop rumble: %v", err)
+	}
+
+	return nil
+}
+
+//Close frees the haptic device
+func (hd *sdlHapticDevice) Close() {
+	hd.mHaptic.Close()
+	hd.mHaptic = nil
+}
+
+//nullHapticDevice is used when there is no force feedback, it supports nothing and never fails
+type nullHapticDevice struct{}
+
+//Capabilities reports no effects
+func (nd nullHapticDevice) Capabilities() uint32 {
+	return 0
+}
+
+//SupportsRumble reports no rumble
+func (nd nullHapticDevice) SupportsRumble() bool {
+	return false
+}
+
+//NewEffect does nothing
+func (nd nullHapticDevice) NewEffect(effect sdl.HapticEffect) (int, error) {
+	return -1, nil
+}
+
+//RunEffect does nothing
+func (nd nullHapticDevice) RunEffect(id int, iterations uint32) error {
+	return nil
+}
+
+//StopEffect does nothing
+func (nd nullHapticDevice) StopEffect(id int) error {
+	return nil
+}
+
+//DestroyEffect does nothing
+func (nd nullHapticDevice) DestroyEffect(id int) {}
+
+//RumblePlay does nothing
+func (nd nullHapticDevice) RumblePlay(strength float32, length uint32) error {
+	return nil
+}
+
+//RumbleStop does nothing
+func (nd nullHapticDevice) RumbleStop() error {
+	return nil
+}
+
+//Close does nothing
+func (nd nullHapticDevice) Close() {}
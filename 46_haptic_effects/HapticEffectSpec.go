@@ -0,0 +1,146 @@
+package main
+
+import (
+	"math"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//HapticEffectKind is the shape of a haptic effect
+type HapticEffectKind int
+
+//Haptic effect kind enum
+const (
+	hapticConstant HapticEffectKind = iota
+	hapticSine
+	hapticTriangle
+	hapticSawtoothUp
+	hapticSawtoothDown
+	hapticRamp
+	hapticLeftRight
+)
+
+//HapticEffectSpec describes an effect independently of the device playing it
+type HapticEffectSpec struct {
+	mKind HapticEffectKind
+
+	//Duration in milliseconds
+	mLength uint32
+
+	//Strength from 0 to 1, ramps go from start to end
+	mStart float32
+	mEnd   float32
+
+	//Wave period in milliseconds
+	mPeriod uint16
+
+	//Motor strengths from 0 to 1
+	mLargeMotor float32
+	mSmallMotor float32
+}
+
+//ConstantEffect creates an effect with a steady force
+func ConstantEffect(strength float32, length uint32) HapticEffectSpec {
+	return HapticEffectSpec{mKind: hapticConstant, mLength: length, mStart: strength, mEnd: strength}
+}
+
+//PeriodicEffect creates a sine, triangle or sawtooth wave effect
+func PeriodicEffect(kind HapticEffectKind, strength float32, period uint16, length uint32) HapticEffectSpec {
+	return HapticEffectSpec{mKind: kind, mLength: length, mStart: strength, mEnd: strength, mPeriod: period}
+}
+
+//RampEffect creates an effect that goes from one strength to another
+func RampEffect(start, end float32, length uint32) HapticEffectSpec {
+	return HapticEffectSpec{mKind: hapticRamp, mLength: length, mStart: start, mEnd: end}
+}
+
+//LeftRightEffect creates an effect controlling the large and small motors directly
+func LeftRightEffect(largeMotor, smallMotor float32, length uint32) HapticEffectSpec {
+	return HapticEffectSpec{mKind: hapticLeftRight, mLength: length,
+		mStart:      float32(math.Max(float64(largeMotor), float64(smallMotor))),
+		mEnd:        float32(math.Max(float64(largeMotor), float64(smallMotor))),
+		mLargeMotor: largeMotor, mSmallMotor: smallMotor}
+}
+
+//Length gets the effect duration in milliseconds
+func (hs HapticEffectSpec) Length() uint32 {
+	return hs.mLength
+}
+
+//Capability gets the HAPTIC_* flag a device needs to play the effect
+func (hs HapticEffectSpec) Capability() uint32 {
+	switch hs.mKind {
+	case hapticConstant:
+		return sdl.HAPTIC_CONSTANT
+	case hapticSine:
+		return sdl.HAPTIC_SINE
+	case hapticTriangle:
+		return sdl.HAPTIC_TRIANGLE
+	case hapticSawtoothUp:
+		return sdl.HAPTIC_SAWTOOTHUP
+	case hapticSawtoothDown:
+		return sdl.HAPTIC_SAWTOOTHDOWN
+	case hapticRamp:
+		return sdl.HAPTIC_RAMP
+	case hapticLeftRight:
+		return sdl.HAPTIC_LEFTRIGHT
+	}
+
+	return 0
+}
+
+//RumbleStrength gets the strength to use when falling back to simple rumble
+func (hs HapticEffectSpec) RumbleStrength() float32 {
+	return float32(math.Max(float64(hs.mStart), float64(hs.mEnd)))
+}
+
+//SDLEffect builds the SDL effect data
+func (hs HapticEffectSpec) SDLEffect() sdl.HapticEffect {
+	//Effects push along the X axis
+	direction := sdl.HapticDirection{Type: sdl.HAPTIC_CARTESIAN, Dir: [3]int32{1, 0, 0}}
+
+	switch hs.mKind {
+	case hapticConstant:
+		return &sdl.HapticConstant{
+			Type:      sdl.HAPTIC_CONSTANT,
+			Direction: direction,
+			Length:    hs.mLength,
+			Level:     hapticLevel(hs.mStart),
+		}
+	case hapticSine, hapticTriangle, hapticSawtoothUp, hapticSawtoothDown:
+		return &sdl.HapticPeriodic{
+			Type:      uint16(hs.Capability()),
+			Direction: direction,
+			Length:    hs.mLength,
+			Period:    hs.mPeriod,
+			Magnitude: hapticLevel(hs.mStart),
+		}
+	case hapticRamp:
+		return &sdl.HapticRamp{
+			Type:      sdl.HAPTIC_RAMP,
+			Direction: direction,
+			Length:    hs.mLength,
+			Start:     hapticLevel(hs.mStart),
+			End:       hapticLevel(hs.mEnd),
+		}
+	case hapticLeftRight:
+		return &sdl.HapticLeftRight{
+			Type:           sdl.HAPTIC_LEFTRIGHT,
+			Length:         hs.mLength,
+			LargeMagnitude: hapticMagnitude(hs.mLargeMotor),
+			SmallMagnitude: hapticMagnitude(hs.mSmallMotor),
+		}
+	}
+
+	return nil
+}
+
+//hapticLevel converts a -1 to 1 strength to a signed effect level
+func hapticLevel(strength float32) int16 {
+	return int16(math.Max(-1, math.Min(1, float64(strength))) * math.MaxInt16)
+}
+
+//hapticMagnitude converts a 0 to 1 strength to a motor magnitude
+func hapticMagnitude(strength float32) uint16 {
+	return uint16(math.Max(0, math.Min(1, float64(strength))) * math.MaxUint16)
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//hapticController holds a controller's device and effect queue
+type hapticController struct {
+	//Device and the joystick it belongs to
+	mDevice   HapticDevice
+	mJoystick *sdl.Joystick
+
+	//Uploaded effects by name
+	mEffects map[string]int
+
+	//Effect playing and when it finishes
+	mPlaying    string
+	mPlayingEnd uint32
+
+	//Effects waiting for the playing one to finish
+	mQueue []string
+}
+
+//HapticsService plays named effects on every connected controller
+type HapticsService struct {
+	//Named effects that can be played
+	mLibrary map[string]HapticEffectSpec
+
+	//Controllers by joystick instance id
+	mControllers map[sdl.JoystickID]*hapticController
+
+	//Gets the current ticks, effects end relative to when they start
+	mClock func() uint32
+}
+
+//NewHapticsService initializes an empty service
+func NewHapticsService() *HapticsService {
+	return &HapticsService{
+		mLibrary:     make(map[string]HapticEffectSpec),
+		mControllers: make(map[sdl.JoystickID]*hapticController),
+		mClock:       sdl.GetTicks,
+	}
+}
+
+//Register adds a named effect to the library
+func (hs *HapticsService) Register(name string, spec HapticEffectSpec) {
+	hs.mLibrary[name] = spec
+
+	//Effects uploaded under the old spec are stale
+	for _, c := range hs.mControllers {
+		if id, ok := c.mEffects[name]; ok {
+			//Don't destroy it while it's playing
+			if c.mPlaying == name {
+				if err := hs.stopPlaying(c); err != nil {
+					fmt.Printf("Warning: %v\n", err)
+				}
+			}
+			c.mDevice.DestroyEffect(id)
+			delete(c.mEffects, name)
+		}
+	}
+}
+
+//OpenController opens the joystick at a device index and its haptic device
+func (hs *HapticsService) OpenController(index int) (sdl.JoystickID, error) {
+	joystick := sdl.JoystickOpen(index)
+	if joystick == nil {
+		return -1, fmt.Errorf("unable to open joystick %d: %v", index, sdl.GetError())
+	}
+
+	id := joystick.InstanceID()
+	hs.AddController(id, OpenHapticDevice(joystick))
+	hs.mControllers[id].mJoystick = joystick
+
+	return id, nil
+}
+
+//AddController adds a controller using a given device
+func (hs *HapticsService) AddController(id sdl.JoystickID, device HapticDevice) {
+	//Replace controller using the same id
+	hs.CloseController(id)
+
+	hs.mControllers[id] = &hapticController{mDevice: device, mEffects: make(map[string]int)}
+}
+
+//CloseController frees a controller's effects and device
+func (hs *HapticsService) CloseController(id sdl.JoystickID) {
+	c, ok := hs.mControllers[id]
+	if !ok {
+		return
+	}
+
+	for _, effect := range c.mEffects {
+		c.mDevice.DestroyEffect(effect)
+	}
+	c.mDevice.Close()
+	if c.mJoystick != nil {
+		c.mJoystick.Close()
+	}
+
+	delete(hs.mControllers, id)
+}
+
+//Close frees every controller
+func (hs *HapticsService) Close() {
+	for id := range hs.mControllers {
+		hs.CloseController(id)
+	}
+}
+
+//Controllers gets the ids of every open controller
+func (hs *HapticsService) Controllers() []sdl.JoystickID {
+	ids := make([]sdl.JoystickID, 0, len(hs.mControllers))
+	for id := range hs.mControllers {
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+//Capabilities gets a controller's supported HAPTIC_* flags
+func (hs *HapticsService) Capabilities(id sdl.JoystickID) uint32 {
+	c, ok := hs.mControllers[id]
+	if !ok {
+		return 0
+	}
+
+	return c.mDevice.Capabilities()
+}
+
+//Supports checks if a controller can play a named effect as it is, without falling back to rumble
+func (hs *HapticsService) Supports(id sdl.JoystickID, name string) bool {
+	spec, ok := hs.mLibrary[name]
+	return ok && hs.Capabilities(id)&spec.Capability() != 0
+}
+
+//Play stops whatever the controller is playing and plays a named effect
+func (hs *HapticsService) Play(id sdl.JoystickID, name string) error {
+	c, ok := hs.mControllers[id]
+	if !ok {
+		return fmt.Errorf("no controller with id %d", id)
+	}
+	if _, ok := hs.mLibrary[name]; !ok {
+		return fmt.Errorf("unknown haptic effect %q", name)
+	}
+
+	if err := hs.stopPlaying(c); err != nil {
+		return err
+	}
+
+	return hs.start(c, name)
+}
+
+//Queue plays a named effect once the controller's current effects are done
+func (hs *HapticsService) Queue(id sdl.JoystickID, name string) error {
+	c, ok := hs.mControllers[id]
+	if !ok {
+		return fmt.Errorf("no controller with id %d", id)
+	}
+	if _, ok := hs.mLibrary[name]; !ok {
+		return fmt.Errorf("unknown haptic effect %q", name)
+	}
+
+	//Nothing playing so start right away
+	if c.mPlaying == "" {
+		return hs.start(c, name)
+	}
+
+	c.mQueue = append(c.mQueue, name)
+	return nil
+}
+
+//Cancel stops a named effect and removes it from the controller's queue
+func (hs *HapticsService) Cancel(id sdl.JoystickID, name string) error {
+	c, ok := hs.mControllers[id]
+	if !ok {
+		return fmt.Errorf("no controller with id %d", id)
+	}
+
+	//Remove queued copies
+	queue := c.mQueue[:0]
+	for _, queued := range c.mQueue {
+		if queued != name {
+			queue = append(queue, queued)
+		}
+	}
+	c.mQueue = queue
+
+	if c.mPlaying == name {
+		return hs.stopPlaying(c)
+	}
+
+	return nil
+}
+
+//CancelAll stops the controller's effect and clears its queue
+func (hs *HapticsService) CancelAll(id sdl.JoystickID) error {
+	c, ok := hs.mControllers[id]
+	if !ok {
+		return fmt.Errorf("no controller with id %d", id)
+	}
+
+	c.mQueue = nil
+	return hs.stopPlaying(c)
+}
+
+//Playing gets the name of the effect a controller is playing
+func (hs *HapticsService) Playing(id sdl.JoystickID) string {
+	c, ok := hs.mControllers[id]
+	if !ok {
+		return ""
+	}
+
+	return c.mPlaying
+}
+
+//Update starts queued effects once the ones before them are done
+//Every controller is serviced even if some fail, their errors are joined
+func (hs *HapticsService) Update() error {
+	//Same clock the effects' end times come from
+	ticks := hs.mClock()
+
+	var errs []error
+	for id, c := range hs.mControllers {
+		//Still playing
+		if c.mPlaying != "" && int32(ticks-c.mPlayingEnd) < 0 {
+			continue
+		}
+		c.mPlaying = ""
+
+		//Start next effect
+		if len(c.mQueue) > 0 {
+			next := c.mQueue[0]
+			c.mQueue = c.mQueue[1:]
+			if err := hs.start(c, next); err != nil {
+				errs = append(errs, fmt.Errorf("could not play queued effect on controller %d: %v", id, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+//start plays a named effect, falling back to rumble if the device can't play it
+func (hs *HapticsService) start(c *hapticController, name string) error {
+	spec, ok := hs.mLibrary[name]
+	if !ok {
+		return fmt.Errorf("unknown haptic effect %q", name)
+	}
+
+	if c.mDevice.Capabilities()&spec.Capability() != 0 {
+		//Upload effect the first time it's played
+		effect, ok := c.mEffects[name]
+		if !ok {
+			var err error
+			if effect, err = c.mDevice.NewEffect(spec.SDLEffect()); err != nil {
+				return err
+			}
+			c.mEffects[name] = effect
+		}
+
+		if err := c.mDevice.RunEffect(effect, 1); err != nil {
+			return err
+		}
+	} else if c.mDevice.SupportsRumble() {
+		//Closest thing the device can do
+		if err := c.mDevice.RumblePlay(spec.RumbleStrength(), spec.Length()); err != nil {
+			return err
+		}
+	}
+
+	//Keep track of the effect even when nothing can be felt so queues behave the same
+	c.mPlaying = name
+	c.mPlayingEnd = hs.mClock() + spec.Length()
+
+	return nil
+}
+
+//stopPlaying stops the controller's current effect
+func (hs *HapticsService) stopPlaying(c *hapticController) error {
+	if c.mPlaying == "" {
+		return nil
+	}
+
+	name := c.mPlaying
+	c.mPlaying = ""
+
+	if effect, ok := c.mEffects[name]; ok {
+		return c.mDevice.StopEffect(effect)
+	}
+	if c.mDevice.SupportsRumble() {
+		return c.mDevice.RumbleStop()
+	}
+
+	return nil
+}
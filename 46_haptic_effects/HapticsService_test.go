@@ -0,0 +1,367 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//fakeHapticDevice records the calls made to it
+type fakeHapticDevice struct {
+	mCapabilities uint32
+	mRumble       bool
+
+	//Calls in the order they were made
+	mCalls []string
+
+	//Ids handed out by NewEffect
+	mNextEffect int
+
+	//Error returned by RunEffect
+	mRunErr error
+}
+
+func (fd *fakeHapticDevice) Capabilities() uint32 {
+	return fd.mCapabilities
+}
+
+func (fd *fakeHapticDevice) SupportsRumble() bool {
+	return fd.mRumble
+}
+
+func (fd *fakeHapticDevice) NewEffect(effect sdl.HapticEffect) (int, error) {
+	id := fd.mNextEffect
+	fd.mNextEffect++
+	fd.mCalls = append(fd.mCalls, fmt.Sprintf("new %d", id))
+	return id, nil
+}
+
+func (fd *fakeHapticDevice) RunEffect(id int, iterations uint32) error {
+	fd.mCalls = append(fd.mCalls, fmt.Sprintf("run %d", id))
+	return fd.mRunErr
+}
+
+func (fd *fakeHapticDevice) StopEffect(id int) error {
+	fd.mCalls = append(fd.mCalls, fmt.Sprintf("stop %d", id))
+	return nil
+}
+
+func (fd *fakeHapticDevice) DestroyEffect(id int) {
+	fd.mCalls = append(fd.mCalls, fmt.Sprintf("destroy %d", id))
+}
+
+func (fd *fakeHapticDevice) RumblePlay(strength float32, length uint32) error {
+	fd.mCalls = append(fd.mCalls, fmt.Sprintf("rumble %.2f %d", strength, length))
+	return nil
+}
+
+func (fd *fakeHapticDevice) RumbleStop() error {
+	fd.mCalls = append(fd.mCalls, "rumble stop")
+	return nil
+}
+
+func (fd *fakeHapticDevice) Close() {
+	fd.mCalls = append(fd.mCalls, "close")
+}
+
+//takeCalls gets the calls made since the last time and forgets them
+func (fd *fakeHapticDevice) takeCalls() []string {
+	calls := fd.mCalls
+	fd.mCalls = nil
+	return calls
+}
+
+//newTestService creates a service with a fake clock and one controller using a device
+func newTestService(device HapticDevice) (*HapticsService, *uint32) {
+	ticks := new(uint32)
+
+	hs := NewHapticsService()
+	hs.mClock = func() uint32 { return *ticks }
+	hs.Register("constant", ConstantEffect(0.5, 100))
+	hs.Register("sine", PeriodicEffect(hapticSine, 0.75, 50, 200))
+	hs.AddController(1, device)
+
+	return hs, ticks
+}
+
+//checkCalls fails the test if the device wasn't called as expected
+func checkCalls(t *testing.T, device *fakeHapticDevice, want ...string) {
+	t.Helper()
+
+	if got := device.takeCalls(); !reflect.DeepEqual(got, want) {
+		t.Errorf("device calls = %q, want %q", got, want)
+	}
+}
+
+//checkPlaying fails the test if the controller isn't playing the expected effect
+func checkPlaying(t *testing.T, hs *HapticsService, want string) {
+	t.Helper()
+
+	if got := hs.Playing(1); got != want {
+		t.Errorf("playing %q, want %q", got, want)
+	}
+}
+
+func TestHapticsQueue(t *testing.T) {
+	device := &fakeHapticDevice{mCapabilities: sdl.HAPTIC_CONSTANT | sdl.HAPTIC_SINE}
+	hs, ticks := newTestService(device)
+
+	//Queueing with nothing playing starts right away
+	*ticks = 1000
+	if err := hs.Queue(1, "constant"); err != nil {
+		t.Fatal(err)
+	}
+	if err := hs.Queue(1, "sine"); err != nil {
+		t.Fatal(err)
+	}
+	if err := hs.Queue(1, "constant"); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device, "new 0", "run 0")
+	checkPlaying(t, hs, "constant")
+
+	//Nothing changes until the effect is done
+	*ticks = 1099
+	if err := hs.Update(); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device)
+	checkPlaying(t, hs, "constant")
+
+	//Next effect gets uploaded and ends relative to when it starts
+	*ticks = 1150
+	if err := hs.Update(); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device, "new 1", "run 1")
+	checkPlaying(t, hs, "sine")
+	*ticks = 1349
+	if err := hs.Update(); err != nil {
+		t.Fatal(err)
+	}
+	checkPlaying(t, hs, "sine")
+
+	//Uploaded effects are reused
+	*ticks = 1350
+	if err := hs.Update(); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device, "run 0")
+	checkPlaying(t, hs, "constant")
+
+	//Queue runs dry
+	*ticks = 1450
+	if err := hs.Update(); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device)
+	checkPlaying(t, hs, "")
+
+	//Unknown effects are rejected
+	if err := hs.Queue(1, "missing"); err == nil {
+		t.Error("queued an unknown effect")
+	}
+	if err := hs.Queue(2, "constant"); err == nil {
+		t.Error("queued on an unknown controller")
+	}
+}
+
+func TestHapticsPlay(t *testing.T) {
+	device := &fakeHapticDevice{mCapabilities: sdl.HAPTIC_CONSTANT | sdl.HAPTIC_SINE}
+	hs, ticks := newTestService(device)
+
+	*ticks = 500
+	if err := hs.Play(1, "constant"); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device, "new 0", "run 0")
+
+	//Playing another effect stops the current one but keeps the queue
+	if err := hs.Queue(1, "constant"); err != nil {
+		t.Fatal(err)
+	}
+	if err := hs.Play(1, "sine"); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device, "stop 0", "new 1", "run 1")
+	checkPlaying(t, hs, "sine")
+
+	//An unknown effect doesn't interrupt the current one
+	if err := hs.Play(1, "missing"); err == nil {
+		t.Error("played an unknown effect")
+	}
+	checkCalls(t, device)
+	checkPlaying(t, hs, "sine")
+
+	//Queue picks up after the new effect
+	*ticks = 700
+	if err := hs.Update(); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device, "run 0")
+	checkPlaying(t, hs, "constant")
+}
+
+func TestHapticsCancel(t *testing.T) {
+	device := &fakeHapticDevice{mCapabilities: sdl.HAPTIC_CONSTANT | sdl.HAPTIC_SINE}
+	hs, ticks := newTestService(device)
+
+	for _, name := range []string{"constant", "sine", "constant", "sine"} {
+		if err := hs.Queue(1, name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	device.takeCalls()
+
+	//Cancel stops the playing effect and drops its queued copies
+	if err := hs.Cancel(1, "constant"); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device, "stop 0")
+	checkPlaying(t, hs, "")
+	if want := []string{"sine", "sine"}; !reflect.DeepEqual(hs.mControllers[1].mQueue, want) {
+		t.Errorf("queue = %q, want %q", hs.mControllers[1].mQueue, want)
+	}
+
+	//Next update starts what's left
+	*ticks = 0
+	if err := hs.Update(); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device, "new 1", "run 1")
+	checkPlaying(t, hs, "sine")
+
+	//CancelAll stops everything
+	if err := hs.CancelAll(1); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device, "stop 1")
+	checkPlaying(t, hs, "")
+	*ticks = 1000
+	if err := hs.Update(); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device)
+	checkPlaying(t, hs, "")
+}
+
+func TestHapticsRumbleFallback(t *testing.T) {
+	//Only sine is supported, constant falls back to rumble
+	device := &fakeHapticDevice{mCapabilities: sdl.HAPTIC_SINE, mRumble: true}
+	hs, ticks := newTestService(device)
+
+	if hs.Supports(1, "constant") || !hs.Supports(1, "sine") {
+		t.Errorf("supports constant %v, sine %v, want false, true", hs.Supports(1, "constant"), hs.Supports(1, "sine"))
+	}
+
+	if err := hs.Play(1, "constant"); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device, "rumble 0.50 100")
+	if err := hs.Play(1, "sine"); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device, "rumble stop", "new 0", "run 0")
+
+	//Without rumble the effect is only kept track of
+	device = &fakeHapticDevice{}
+	hs, ticks = newTestService(device)
+	if err := hs.Queue(1, "constant"); err != nil {
+		t.Fatal(err)
+	}
+	if err := hs.Queue(1, "sine"); err != nil {
+		t.Fatal(err)
+	}
+	checkPlaying(t, hs, "constant")
+	*ticks = 100
+	if err := hs.Update(); err != nil {
+		t.Fatal(err)
+	}
+	checkPlaying(t, hs, "sine")
+	if err := hs.CancelAll(1); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device)
+}
+
+func TestHapticsNullDevice(t *testing.T) {
+	hs, ticks := newTestService(nullHapticDevice{})
+
+	//Queues behave the same with nothing to feel
+	if err := hs.Queue(1, "constant"); err != nil {
+		t.Fatal(err)
+	}
+	if err := hs.Queue(1, "sine"); err != nil {
+		t.Fatal(err)
+	}
+	*ticks = 100
+	if err := hs.Update(); err != nil {
+		t.Fatal(err)
+	}
+	checkPlaying(t, hs, "sine")
+	if err := hs.Cancel(1, "sine"); err != nil {
+		t.Fatal(err)
+	}
+	checkPlaying(t, hs, "")
+	hs.Close()
+}
+
+func TestHapticsRegisterWhilePlaying(t *testing.T) {
+	device := &fakeHapticDevice{mCapabilities: sdl.HAPTIC_CONSTANT | sdl.HAPTIC_SINE}
+	hs, _ := newTestService(device)
+
+	if err := hs.Play(1, "constant"); err != nil {
+		t.Fatal(err)
+	}
+	device.takeCalls()
+
+	//Replacing the playing effect stops it before it's destroyed
+	hs.Register("constant", ConstantEffect(1, 300))
+	checkCalls(t, device, "stop 0", "destroy 0")
+	checkPlaying(t, hs, "")
+
+	//New spec gets uploaded next time
+	if err := hs.Play(1, "constant"); err != nil {
+		t.Fatal(err)
+	}
+	checkCalls(t, device, "new 1", "run 1")
+
+	//Closing frees what's left
+	hs.Close()
+	checkCalls(t, device, "destroy 1", "close")
+}
+
+func TestHapticsUpdateErrors(t *testing.T) {
+	broken := &fakeHapticDevice{mCapabilities: sdl.HAPTIC_CONSTANT, mRunErr: errors.New("device unplugged")}
+	working := &fakeHapticDevice{mCapabilities: sdl.HAPTIC_CONSTANT}
+	hs, ticks := newTestService(broken)
+	hs.AddController(2, working)
+	hs.AddController(3, broken)
+
+	//Queue a second effect behind the first on every controller
+	for _, id := range []sdl.JoystickID{1, 2, 3} {
+		hs.mControllers[id].mPlaying = "constant"
+		hs.mControllers[id].mQueue = []string{"constant"}
+	}
+	broken.takeCalls()
+
+	//Broken controllers don't keep the others from starting their queued effects
+	*ticks = 100
+	err := hs.Update()
+	if err == nil {
+		t.Fatal("update didn't report the broken controllers")
+	}
+	for _, id := range []string{"1", "3"} {
+		if !strings.Contains(err.Error(), "controller "+id) {
+			t.Errorf("error %q doesn't mention controller %v", err, id)
+		}
+	}
+	checkCalls(t, working, "new 0", "run 0")
+	if got := hs.Playing(2); got != "constant" {
+		t.Errorf("working controller playing %q, want constant", got)
+	}
+}
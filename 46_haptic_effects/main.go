@@ -0,0 +1,245 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/veandco/go-sdl2/img"
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/veandco/go-sdl2/ttf"
+)
+
+//Screen dimension constants
+const (
+	screenWitdh  = 640
+	screenHeight = 480
+)
+
+//Effects played by the number keys and joystick buttons in order
+var effectNames = []string{"constant", "sine", "triangle", "sawtooth", "ramp_up", "ramp_down", "left_right"}
+
+var (
+	//The window we'll be rendering to
+	gWindow *sdl.Window
+
+	//The window renderer
+	gRenderer *sdl.Renderer
+
+	//Globally used font
+	gFont *ttf.Font
+
+	//Scene texture
+	gSplashTexture LTexture
+
+	//Force feedback for every controller
+	gHaptics *HapticsService
+)
+
+func main() {
+	//Start up SDL and create window
+	if err := initSDl(); err != nil {
+		log.Fatalf("Could not init SDL: %v\n", err)
+	}
+
+	//Load media
+	if err := loadMedia(); err != nil {
+		log.Fatalf("Could not load media: %v\n", err)
+	}
+
+	//Main loop flag
+	var quit bool
+
+	//Event handler
+	var e sdl.Event
+
+	//While application is running
+	for !quit {
+		//Handle events on queue
+		for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+			switch e.GetType() {
+			//User requests quit
+			case sdl.QUIT:
+				quit = true
+				break
+
+			//Joystick connected, also sent for joysticks connected at startup
+			case sdl.JOYDEVICEADDED:
+				id, err := gHaptics.OpenController(int((e.(*sdl.JoyDeviceAddedEvent)).Which))
+				if err != nil {
+					fmt.Printf("Warning: %v\n", err)
+				} else {
+					fmt.Printf("Controller %d connected, haptic capabilities: %#x\n", id, gHaptics.Capabilities(id))
+				}
+				break
+
+			//Joystick disconnected
+			case sdl.JOYDEVICEREMOVED:
+				gHaptics.CloseController((e.(*sdl.JoyDeviceRemovedEvent)).Which)
+				break
+
+			//Joystick button press plays an effect on that controller
+			case sdl.JOYBUTTONDOWN:
+				jEvent := e.(*sdl.JoyButtonEvent)
+				name := effectNames[int(jEvent.Button)%len(effectNames)]
+				if err := gHaptics.Play(jEvent.Which, name); err != nil {
+					fmt.Printf("Warning: Unable to play %s! %v\n", name, err)
+				}
+				break
+
+			//Keys control every controller
+			case sdl.KEYDOWN:
+				handleKey((e.(*sdl.KeyboardEvent)).Keysym.Sym)
+				break
+			}
+		}
+
+		//Start queued effects
+		if err := gHaptics.Update(); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		}
+
+		//Clear screen
+		err := gRenderer.SetDrawColor(255, 255, 255, 255)
+		if err != nil {
+			log.Fatalf("could not set draw color for renderer: %v", err)
+		}
+		err = gRenderer.Clear()
+		if err != nil {
+			log.Fatalf("could not clear renderer: %v", err)
+		}
+
+		err = gSplashTexture.Render(0, 0, nil, 0, nil, sdl.FLIP_NONE)
+		if err != nil {
+			log.Fatalf("could not render splash texture: %v", err)
+		}
+
+		//Update screen
+		gRenderer.Present()
+	}
+
+	//Free resources and close SDL
+	if err := close(); err != nil {
+		log.Fatalf("Could not close SDL! SDL Error: %v\n", err)
+	}
+}
+
+func initSDl() error {
+	//Local error declaration
+	var err error
+
+	//Initialize SDL
+	if err := sdl.Init(sdl.INIT_VIDEO | sdl.INIT_JOYSTICK | sdl.INIT_HAPTIC); err != nil {
+		return fmt.Errorf("SDL could not initialize! SDL_ERROR: %v", err)
+	}
+
+	//Set texture filtering to linear
+	if !sdl.SetHint(sdl.HINT_RENDER_SCALE_QUALITY, "1") {
+		fmt.Printf("Warning: Linear texture filtering not enabled!")
+	}
+
+	//Create Window
+	gWindow, err = sdl.CreateWindow("SDL Tutorial", sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED,
+		screenWitdh, screenHeight, sdl.WINDOW_SHOWN)
+	if err != nil {
+		return fmt.Errorf("Window could not be created! SDL_Error: %v", err)
+	}
+
+	//Create vsynced renderer for window
+	if gRenderer, err = sdl.CreateRenderer(gWindow, -1, sdl.RENDERER_ACCELERATED|sdl.RENDERER_PRESENTVSYNC); err != nil {
+		return fmt.Errorf("Renderer could not be created! SDL Error: %v", err)
+	}
+
+	//Initialize renderer color
+	gRenderer.SetDrawColor(255, 255, 255, 255)
+
+	//Initialize PNG loading
+	imgFlags := img.INIT_PNG
+	if (img.Init(imgFlags) & imgFlags) == 0 {
+		return fmt.Errorf("SDL_image could not initialize! SDL_image Error: %v", img.GetError())
+	}
+
+	return nil
+}
+
+func loadMedia() error {
+	//Local error declaration
+	var err error
+
+	//Load press texture
+	err = gSplashTexture.LoadFromFile("splash.png")
+	if err != nil {
+		return fmt.Errorf("failed to load splash texture: %v", err)
+	}
+
+	//Fill the effect library
+	gHaptics = NewHapticsService()
+	gHaptics.Register("constant", ConstantEffect(0.75, 500))
+	gHaptics.Register("sine", PeriodicEffect(hapticSine, 0.75, 100, 1000))
+	gHaptics.Register("triangle", PeriodicEffect(hapticTriangle, 0.75, 250, 1000))
+	gHaptics.Register("sawtooth", PeriodicEffect(hapticSawtoothUp, 0.75, 250, 1000))
+	gHaptics.Register("ramp_up", RampEffect(0, 1, 1000))
+	gHaptics.Register("ramp_down", RampEffect(1, 0, 1000))
+	gHaptics.Register("left_right", LeftRightEffect(1, 0.25, 500))
+
+	return nil
+}
+
+func close() error {
+	//Free loaded images
+	if err := gSplashTexture.Free(); err != nil {
+		return fmt.Errorf("could not free splash texture: %v", err)
+	}
+
+	//Close game controllers
+	gHaptics.Close()
+	gHaptics = nil
+
+	//Destroy window
+	if err := gRenderer.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy global renderer: %v", err)
+	}
+	if err := gWindow.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy window: %v", err)
+	}
+	gWindow = nil
+	gRenderer = nil
+
+	//Quit SDL Subsystems
+	ttf.Quit()
+	img.Quit()
+	sdl.Quit()
+
+	return nil
+}
+
+//Plays, queues or cancels effects on every controller
+func handleKey(key sdl.Keycode) {
+	for _, id := range gHaptics.Controllers() {
+		var err error
+
+		switch {
+		//Number keys play effects
+		case key >= sdl.K_1 && key < sdl.K_1+sdl.Keycode(len(effectNames)):
+			err = gHaptics.Play(id, effectNames[key-sdl.K_1])
+			break
+
+		//Queue a sequence of effects
+		case key == sdl.K_q:
+			for _, name := range []string{"ramp_up", "sine", "left_right"} {
+				if err = gHaptics.Queue(id, name); err != nil {
+					break
+				}
+			}
+			break
+
+		//Cancel everything
+		case key == sdl.K_c:
+			err = gHaptics.CancelAll(id)
+			break
+		}
+
+		if err != nil {
+			fmt.Printf("Warning: Unable to update effects on controller %d! %v\n", id, err)
+		}
+	}
+}
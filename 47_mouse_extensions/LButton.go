@@ -0,0 +1,99 @@
+//The mouse button
+package main
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//LButtonSprite defines enum type for LButton
+type LButtonSprite int
+
+//Button constants
+const (
+	buttonWidth  = 300
+	buttonHeight = 200
+	totalButtons = 4
+)
+
+//Button sprite enum
+const (
+	buttonSpriteMouseOut LButtonSprite = iota
+	buttonSpriteMouseOverMotion
+	buttonSpriteMouseDown
+	buttonSpriteMouseUp
+	buttonSpriteTotal
+)
+
+//LButton is the struct that loads a button
+type LButton struct {
+	//Top left position
+	mPosition sdl.Point
+
+	//Current used global sprite
+	mCurrentSprite LButtonSprite
+}
+
+//NewLButton initializes internal variables
+func (lb *LButton) NewLButton() {
+	lb.mPosition.X = 0
+	lb.mPosition.Y = 0
+
+	lb.mCurrentSprite = buttonSpriteMouseOut
+}
+
+//SetPosition sets top left position
+func (lb *LButton) SetPosition(x, y int32) {
+	lb.mPosition.X = x
+	lb.mPosition.Y = y
+}
+
+//Position gets top left position
+func (lb *LButton) Position() sdl.Point {
+	return lb.mPosition
+}
+
+//Contains checks if a point on screen is inside the button seen through the camera
+func (lb *LButton) Contains(p sdl.Point, camera sdl.Point) bool {
+	x := p.X + camera.X
+	y := p.Y + camera.Y
+
+	return x >= lb.mPosition.X && x <= lb.mPosition.X+buttonWidth &&
+		y >= lb.mPosition.Y && y <= lb.mPosition.Y+buttonHeight
+}
+
+//HandleEvent handles mouse event
+func (lb *LButton) HandleEvent(e sdl.Event, mouse *Mouse, camera sdl.Point) {
+	//If mouse event happened
+	if e.GetType() == sdl.MOUSEMOTION || e.GetType() == sdl.MOUSEBUTTONDOWN || e.GetType() == sdl.MOUSEBUTTONUP {
+		//Mouse is outside button
+		if !lb.Contains(mouse.Position(), camera) {
+			lb.mCurrentSprite = buttonSpriteMouseOut
+		} else { //Mouse is inside button
+			//Set mouse over sprite
+			switch e.GetType() {
+			case sdl.MOUSEMOTION:
+				lb.mCurrentSprite = buttonSpriteMouseOverMotion
+				break
+			case sdl.MOUSEBUTTONDOWN:
+				lb.mCurrentSprite = buttonSpriteMouseDown
+				break
+			case sdl.MOUSEBUTTONUP:
+				lb.mCurrentSprite = buttonSpriteMouseUp
+				break
+			}
+		}
+	}
+}
+
+//Render shows button sprite
+func (lb *LButton) Render(camera sdl.Point) error {
+	//Show current button sprite
+	err := gButtonSpriteSheetTexture.Render(lb.mPosition.X-camera.X, lb.mPosition.Y-camera.Y,
+		&gSpriteClips[lb.mCurrentSprite], 0, nil, sdl.FLIP_NONE)
+	if err != nil {
+		return fmt.Errorf("could not render button sprite: %v", err)
+	}
+	return nil
+}
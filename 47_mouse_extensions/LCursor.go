@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/img"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//LCursor is a sdl.Cursor wrapper class
+type LCursor struct {
+	//The actual cursor
+	mCursor *sdl.Cursor
+}
+
+//LoadFromFile creates a color cursor from the image at specified path
+func (lc *LCursor) LoadFromFile(path string, hotX, hotY int32) error {
+	//Get rid of preexisting cursor
+	lc.Free()
+
+	//Load image at specified path
+	loadedSurface, err := img.Load(path)
+	if err != nil {
+		return fmt.Errorf("could not load image %v! SDL_image Error: %v", path, err)
+	}
+	defer loadedSurface.Free()
+
+	//Color key image
+	err = loadedSurface.SetColorKey(true, sdl.MapRGB(loadedSurface.Format, 0, 255, 255))
+	if err != nil {
+		return fmt.Errorf("could not set color key: %v", err)
+	}
+
+	//Blit onto a transparent surface so the color keyed pixels stay see-through
+	cursorSurface, err := sdl.CreateRGBSurfaceWithFormat(0, loadedSurface.W, loadedSurface.H, 32,
+		sdl.PIXELFORMAT_ARGB8888)
+	if err != nil {
+		return fmt.Errorf("could not create cursor surface: %v", err)
+	}
+	defer cursorSurface.Free()
+	if err = loadedSurface.Blit(nil, cursorSurface, nil); err != nil {
+		return fmt.Errorf("could not blit cursor surface: %v", err)
+	}
+
+	//Create cursor from surface pixels, SDL keeps its own copy so the surfaces can go
+	lc.mCursor = sdl.CreateColorCursor(cursorSurface, hotX, hotY)
+	if lc.mCursor == nil {
+		return fmt.Errorf("could not create cursor from %v: %v", path, sdl.GetError())
+	}
+
+	return nil
+}
+
+//LoadSystem creates one of the system cursors
+func (lc *LCursor) LoadSystem(id sdl.SystemCursor) error {
+	//Get rid of preexisting cursor
+	lc.Free()
+
+	lc.mCursor = sdl.CreateSystemCursor(id)
+	if lc.mCursor == nil {
+		return fmt.Errorf("could not create system cursor %d: %v", id, sdl.GetError())
+	}
+
+	return nil
+}
+
+//Set makes this the active cursor
+func (lc *LCursor) Set() {
+	//Avoid resetting the same cursor every frame
+	if lc.mCursor != nil && sdl.GetCursor() != lc.mCursor {
+		sdl.SetCursor(lc.mCursor)
+	}
+}
+
+//Free deallocates the cursor
+func (lc *LCursor) Free() {
+	if lc.mCursor != nil {
+		sdl.FreeCursor(lc.mCursor)
+		lc.mCursor = nil
+	}
+}
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	//Distance the mouse has to move while held down before it counts as a drag
+	dragThreshold = 5
+
+	//Time and distance allowed between the clicks of a double click
+	doubleClickTime     = 400
+	doubleClickDistance = 4
+
+	//Buttons tracked, BUTTON_LEFT through BUTTON_X2
+	mouseButtonCount = 5
+)
+
+//mouseButton keeps track of a button's presses for drags and clicks
+type mouseButton struct {
+	//Held state and where it was pressed
+	mDown   bool
+	mDownAt sdl.Point
+
+	//The press moved past the drag threshold
+	mDragging bool
+
+	//Last click used for double click detection
+	mLastClickTime uint32
+	mLastClickAt   sdl.Point
+
+	//Gestures that happened this frame
+	mClicked       bool
+	mDoubleClicked bool
+	mDragStarted   bool
+	mDropped       bool
+}
+
+//Mouse tracks the mouse over a frame: position, wheel, relative motion and gestures
+type Mouse struct {
+	//Cursor position in the window
+	mPosition sdl.Point
+
+	//Motion and wheel scroll this frame
+	mMotion sdl.Point
+	mWheel  sdl.Point
+
+	//Relative mode hides the cursor and only reports motion
+	mRelative bool
+
+	//Button states
+	mButtons [mouseButtonCount]mouseButton
+}
+
+//NewFrame clears last frame's motion, wheel and gestures
+//It must be called before the frame's events are handled
+func (m *Mouse) NewFrame() {
+	m.mMotion = sdl.Point{}
+	m.mWheel = sdl.Point{}
+
+	for i := range m.mButtons {
+		b := &m.mButtons[i]
+		b.mClicked = false
+		b.mDoubleClicked = false
+		b.mDragStarted = false
+		b.mDropped = false
+	}
+}
+
+//HandleEvent handles mouse events
+func (m *Mouse) HandleEvent(e sdl.Event) {
+	switch e.GetType() {
+	case sdl.MOUSEMOTION:
+		mEvent := e.(*sdl.MouseMotionEvent)
+		m.mPosition = sdl.Point{X: mEvent.X, Y: mEvent.Y}
+		m.mMotion.X += mEvent.XRel
+		m.mMotion.Y += mEvent.YRel
+
+		//Start dragging the held buttons that moved far enough
+		for i := range m.mButtons {
+			b := &m.mButtons[i]
+			if b.mDown && !b.mDragging && pointDistance(b.mDownAt, m.mPosition) > dragThreshold {
+				b.mDragging = true
+				b.mDragStarted = true
+			}
+		}
+		break
+
+	case sdl.MOUSEBUTTONDOWN:
+		mEvent := e.(*sdl.MouseButtonEvent)
+		b := m.button(mEvent.Button)
+		if b == nil {
+			break
+		}
+
+		m.mPosition = sdl.Point{X: mEvent.X, Y: mEvent.Y}
+		b.mDown = true
+		b.mDownAt = m.mPosition
+		b.mDragging = false
+		break
+
+	case sdl.MOUSEBUTTONUP:
+		mEvent := e.(*sdl.MouseButtonEvent)
+		b := m.button(mEvent.Button)
+		if b == nil || !b.mDown {
+			break
+		}
+
+		m.mPosition = sdl.Point{X: mEvent.X, Y: mEvent.Y}
+		b.mDown = false
+
+		//Releasing a drag drops it
+		if b.mDragging {
+			b.mDragging = false
+			b.mDropped = true
+			break
+		}
+
+		//Otherwise it's a click, which may complete a double click
+		b.mClicked = true
+		if b.mLastClickTime != 0 && mEvent.Timestamp-b.mLastClickTime <= doubleClickTime &&
+			pointDistance(b.mLastClickAt, m.mPosition) <= doubleClickDistance {
+			b.mDoubleClicked = true
+
+			//A third click starts a new double click
+			b.mLastClickTime = 0
+		} else {
+			b.mLastClickTime = mEvent.Timestamp
+			b.mLastClickAt = m.mPosition
+		}
+		break
+
+	case sdl.MOUSEWHEEL:
+		wEvent := e.(*sdl.MouseWheelEvent)
+
+		//Flipped wheels scroll the other way
+		direction := int32(1)
+		if wEvent.Direction == sdl.MOUSEWHEEL_FLIPPED {
+			direction = -1
+		}
+		m.mWheel.X += wEvent.X * direction
+		m.mWheel.Y += wEvent.Y * direction
+		break
+	}
+}
+
+//button gets the state for a SDL mouse button
+func (m *Mouse) button(button uint8) *mouseButton {
+	if button < sdl.BUTTON_LEFT || int(button) > mouseButtonCount {
+		return nil
+	}
+
+	return &m.mButtons[button-sdl.BUTTON_LEFT]
+}
+
+//SetRelativeMode hides and captures the cursor so motion keeps being reported past the window edges
+func (m *Mouse) SetRelativeMode(enabled bool) error {
+	if sdl.SetRelativeMouseMode(enabled) != 0 {
+		return fmt.Errorf("could not set relative mouse mode: %v", sdl.GetError())
+	}
+
+	m.mRelative = enabled
+	return nil
+}
+
+//IsRelativeMode checks if relative mode is on
+func (m *Mouse) IsRelativeMode() bool {
+	return m.mRelative
+}
+
+//Position gets the cursor position
+func (m *Mouse) Position() sdl.Point {
+	return m.mPosition
+}
+
+//Motion gets how far the mouse moved this frame
+func (m *Mouse) Motion() sdl.Point {
+	return m.mMotion
+}
+
+//Wheel gets how far the wheel scrolled this frame
+func (m *Mouse) Wheel() sdl.Point {
+	return m.mWheel
+}
+
+//IsDown checks if a button is held
+func (m *Mouse) IsDown(button uint8) bool {
+	b := m.button(button)
+	return b != nil && b.mDown
+}
+
+//Clicked checks if a button was pressed and released without dragging this frame
+func (m *Mouse) Clicked(button uint8) bool {
+	b := m.button(button)
+	return b != nil && b.mClicked
+}
+
+//DoubleClicked checks if a button's click this frame completed a double click
+func (m *Mouse) DoubleClicked(button uint8) bool {
+	b := m.button(button)
+	return b != nil && b.mDoubleClicked
+}
+
+//DragStarted checks if a button started dragging this frame
+func (m *Mouse) DragStarted(button uint8) bool {
+	b := m.button(button)
+	return b != nil && b.mDragStarted
+}
+
+//IsDragging checks if a button is dragging
+func (m *Mouse) IsDragging(button uint8) bool {
+	b := m.button(button)
+	return b != nil && b.mDragging
+}
+
+//Dropped checks if a button's drag ended this frame
+func (m *Mouse) Dropped(button uint8) bool {
+	b := m.button(button)
+	return b != nil && b.mDropped
+}
+
+//DragOrigin gets where a button was pressed
+func (m *Mouse) DragOrigin(button uint8) sdl.Point {
+	b := m.button(button)
+	if b == nil {
+		return sdl.Point{}
+	}
+
+	return b.mDownAt
+}
+
+//pointDistance gets the chessboard distance between two points
+func pointDistance(a, b sdl.Point) int32 {
+	dx := a.X - b.X
+	if dx < 0 {
+		dx = -dx
+	}
+
+	dy := a.Y - b.Y
+	if dy < 0 {
+		dy = -dy
+	}
+
+	if dx > dy {
+		return dx
+	}
+	return dy
+}
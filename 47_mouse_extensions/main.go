@@ -0,0 +1,293 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/veandco/go-sdl2/img"
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/veandco/go-sdl2/ttf"
+)
+
+const (
+	//Screen dimension constants
+	screenWitdh  = 640
+	screenHeight = 480
+
+	//Pixels scrolled per wheel notch
+	wheelScrollSpeed = 20
+)
+
+var (
+	//The window we'll be rendering to
+	gWindow *sdl.Window
+
+	//The window renderer
+	gRenderer *sdl.Renderer
+
+	//Globally used font
+	gFont *ttf.Font
+
+	//Mouse Button sprites
+	gSpriteClips              [buttonSpriteTotal]sdl.Rect
+	gButtonSpriteSheetTexture LTexture
+
+	//Button objects
+	gButtons [totalButtons]LButton
+
+	//Cursors
+	gDotCursor  LCursor
+	gHandCursor LCursor
+	gMoveCursor LCursor
+)
+
+func main() {
+	//Start up SDL and create window
+	if err := initSDl(); err != nil {
+		log.Fatalf("Could not init SDL: %v\n", err)
+	}
+
+	//Load media
+	if err := loadMedia(); err != nil {
+		log.Fatalf("Could not load media: %v\n", err)
+	}
+
+	//Main loop flag
+	quit := false
+
+	//Event handler
+	var e sdl.Event
+
+	//Mouse state
+	var mouse Mouse
+
+	//View offset
+	var camera sdl.Point
+
+	//Button being dragged and where it was grabbed
+	dragged := -1
+	var grabOffset sdl.Point
+
+	//While application is running
+	for !quit {
+		//Forget last frame's gestures
+		mouse.NewFrame()
+
+		//Handle events on queue
+		for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+			//User requests quit
+			if e.GetType() == sdl.QUIT {
+				quit = true
+			}
+
+			//Track mouse
+			mouse.HandleEvent(e)
+
+			//Handle button events
+			for i := 0; i < totalButtons; i++ {
+				gButtons[i].HandleEvent(e, &mouse, camera)
+			}
+		}
+
+		//Look around while the right button is held
+		if mouse.IsDown(sdl.BUTTON_RIGHT) != mouse.IsRelativeMode() {
+			if err := mouse.SetRelativeMode(mouse.IsDown(sdl.BUTTON_RIGHT)); err != nil {
+				fmt.Printf("Warning: %v\n", err)
+			}
+		}
+		if mouse.IsRelativeMode() {
+			camera.X -= mouse.Motion().X
+			camera.Y -= mouse.Motion().Y
+		}
+
+		//Scroll with the wheel
+		camera.X += mouse.Wheel().X * wheelScrollSpeed
+		camera.Y -= mouse.Wheel().Y * wheelScrollSpeed
+
+		//Pick up the top button under the drag
+		if mouse.DragStarted(sdl.BUTTON_LEFT) {
+			origin := mouse.DragOrigin(sdl.BUTTON_LEFT)
+			for i := totalButtons - 1; i >= 0; i-- {
+				if gButtons[i].Contains(origin, camera) {
+					dragged = i
+					grabOffset.X = origin.X + camera.X - gButtons[i].Position().X
+					grabOffset.Y = origin.Y + camera.Y - gButtons[i].Position().Y
+					break
+				}
+			}
+		}
+
+		//Move the dragged button with the mouse
+		if dragged >= 0 {
+			gButtons[dragged].SetPosition(mouse.Position().X+camera.X-grabOffset.X,
+				mouse.Position().Y+camera.Y-grabOffset.Y)
+
+			//Drop it
+			if mouse.Dropped(sdl.BUTTON_LEFT) {
+				dragged = -1
+			}
+		}
+
+		//Double click puts everything back
+		if mouse.DoubleClicked(sdl.BUTTON_LEFT) {
+			camera = sdl.Point{}
+			resetButtons()
+		}
+
+		//Pick cursor for what is under the mouse
+		if dragged >= 0 {
+			gMoveCursor.Set()
+		} else {
+			overButton := false
+			for i := 0; i < totalButtons; i++ {
+				if gButtons[i].Contains(mouse.Position(), camera) {
+					overButton = true
+					break
+				}
+			}
+
+			if overButton {
+				gHandCursor.Set()
+			} else {
+				gDotCursor.Set()
+			}
+		}
+
+		//Clear screen
+		err := gRenderer.SetDrawColor(255, 255, 255, 255)
+		if err != nil {
+			log.Fatalf("could not set draw color for renderer: %v", err)
+		}
+		err = gRenderer.Clear()
+		if err != nil {
+			log.Fatalf("could not clear renderer: %v", err)
+		}
+
+		//Render buttons
+		for i := 0; i < totalButtons; i++ {
+			err = gButtons[i].Render(camera)
+			if err != nil {
+				log.Fatalf("could not render button %d: %v", i, err)
+			}
+		}
+
+		//Update screen
+		gRenderer.Present()
+	}
+
+	//Free resources and close SDL
+	if err := close(); err != nil {
+		log.Fatalf("Could not close SDL! SDL Error: %v\n", err)
+	}
+}
+
+func initSDl() error {
+	//Local error declaration
+	var err error
+
+	//Initialize SDL
+	if err := sdl.Init(sdl.INIT_VIDEO); err != nil {
+		return fmt.Errorf("SDL could not initialize! SDL_ERROR: %v", err)
+	}
+
+	//Set texture filtering to linear
+	if !sdl.SetHint(sdl.HINT_RENDER_SCALE_QUALITY, "1") {
+		fmt.Printf("Warning: Linear texture filtering not enabled!")
+	}
+
+	//Create Window
+	gWindow, err = sdl.CreateWindow("SDL Tutorial", sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED,
+		screenWitdh, screenHeight, sdl.WINDOW_SHOWN)
+	if err != nil {
+		return fmt.Errorf("Window could not be created! SDL_Error: %v", err)
+	}
+
+	//Create vsynced renderer for window
+	if gRenderer, err = sdl.CreateRenderer(gWindow, -1, sdl.RENDERER_ACCELERATED|sdl.RENDERER_PRESENTVSYNC); err != nil {
+		return fmt.Errorf("Renderer could not be created! SDL Error: %v", err)
+	}
+
+	//Initialize renderer color
+	gRenderer.SetDrawColor(255, 255, 255, 255)
+
+	//Initialize PNG loading
+	imgFlags := img.INIT_PNG
+	if (img.Init(imgFlags) & imgFlags) == 0 {
+		return fmt.Errorf("SDL_image could not initialize! SDL_image Error: %v", img.GetError())
+	}
+
+	return nil
+}
+
+func loadMedia() error {
+	//Local error declaration
+	var err error
+
+	err = gButtonSpriteSheetTexture.LoadFromFile("button.png")
+	if err != nil {
+		return fmt.Errorf("failed to load button sprite texture: %v", err)
+	}
+
+	//Set sprites
+	for i := 0; i < int(buttonSpriteTotal); i++ {
+		gSpriteClips[i].X = 0
+		gSpriteClips[i].Y = int32(i) * 200
+		gSpriteClips[i].W = buttonWidth
+		gSpriteClips[i].H = buttonHeight
+	}
+
+	//Set buttons in corners
+	resetButtons()
+
+	//Load dot cursor with its hot spot in the middle
+	if err = gDotCursor.LoadFromFile("dot.bmp", 10, 10); err != nil {
+		return fmt.Errorf("failed to load dot cursor: %v", err)
+	}
+
+	//Load system cursors
+	if err = gHandCursor.LoadSystem(sdl.SYSTEM_CURSOR_HAND); err != nil {
+		return fmt.Errorf("failed to load hand cursor: %v", err)
+	}
+	if err = gMoveCursor.LoadSystem(sdl.SYSTEM_CURSOR_SIZEALL); err != nil {
+		return fmt.Errorf("failed to load move cursor: %v", err)
+	}
+
+	return nil
+}
+
+//Sets buttons in corners
+func resetButtons() {
+	gButtons[0].SetPosition(0, 0)
+	gButtons[1].SetPosition(screenWitdh-buttonWidth, 0)
+	gButtons[2].SetPosition(0, screenHeight-buttonHeight)
+	gButtons[3].SetPosition(screenWitdh-buttonWidth, screenHeight-buttonHeight)
+}
+
+func close() error {
+	//Free loaded images
+	if err := gButtonSpriteSheetTexture.Free(); err != nil {
+		return fmt.Errorf("could not free sprite texture: %v", err)
+	}
+
+	//Free cursors
+	gDotCursor.Free()
+	gHandCursor.Free()
+	gMoveCursor.Free()
+
+	//Destroy window
+	if err := gRenderer.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy global renderer: %v", err)
+	}
+	if err := gWindow.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy window: %v", err)
+	}
+	gWindow = nil
+	gRenderer = nil
+
+	//Quit SDL Subsystems
+	ttf.Quit()
+	img.Quit()
+	sdl.Quit()
+
+	return nil
+}
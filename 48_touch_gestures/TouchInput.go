@@ -0,0 +1,301 @@
+package main
+
+import (
+	"math"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	//Distance in pixels a finger can move and still count as a tap or long press
+	touchSlop = 10
+
+	//A tap is released before this many milliseconds
+	tapTime = 250
+
+	//A long press is held at least this many milliseconds
+	longPressTime = 600
+
+	//A swipe travels at least this many pixels in less than swipeTime milliseconds
+	swipeDistance = 80
+	swipeTime     = 500
+)
+
+//SwipeDirection is the main direction of a swipe
+type SwipeDirection int
+
+//Swipe direction enum
+const (
+	swipeNone SwipeDirection = iota
+	swipeLeft
+	swipeRight
+	swipeUp
+	swipeDown
+)
+
+//touchFinger is a finger on the screen
+type touchFinger struct {
+	//Where and when it touched down, in window coordinates
+	mStart     sdl.Point
+	mStartTime uint32
+
+	//Where it is now
+	mPosition sdl.Point
+
+	//It went farther than the slop so it can't be a tap or long press
+	mMoved bool
+
+	//A long press was already reported
+	mLongPressed bool
+}
+
+//TouchInput tracks fingers and recognizes gestures over a frame
+type TouchInput struct {
+	//Window size used to convert normalized touch coordinates
+	mWidth  int32
+	mHeight int32
+
+	//Active fingers by id
+	mFingers map[sdl.FingerID]*touchFinger
+
+	//Finger driving the synthesized mouse
+	mPrimary    sdl.FingerID
+	mHasPrimary bool
+
+	//Gestures that happened this frame
+	mTapped      bool
+	mTapAt       sdl.Point
+	mLongPressed bool
+	mLongPressAt sdl.Point
+	mSwipe       SwipeDirection
+	mPinch       float32
+	mRotation    float32
+	mGestureAt   sdl.Point
+}
+
+//NewTouchInput initializes touch tracking for a window size
+func NewTouchInput(width, height int32) *TouchInput {
+	return &TouchInput{mWidth: width, mHeight: height, mFingers: make(map[sdl.FingerID]*touchFinger)}
+}
+
+//SetWindowSize updates the size used to convert touch coordinates
+func (ti *TouchInput) SetWindowSize(width, height int32) {
+	ti.mWidth = width
+	ti.mHeight = height
+}
+
+//NewFrame clears last frame's gestures
+//It must be called before the frame's events are handled
+func (ti *TouchInput) NewFrame() {
+	ti.mTapped = false
+	ti.mLongPressed = false
+	ti.mSwipe = swipeNone
+	ti.mPinch = 0
+	ti.mRotation = 0
+}
+
+//HandleEvent handles touch events and returns the mouse events the primary finger stands for
+func (ti *TouchInput) HandleEvent(e sdl.Event) []sdl.Event {
+	switch e.GetType() {
+	case sdl.FINGERDOWN:
+		tEvent := e.(*sdl.TouchFingerEvent)
+		position := ti.toWindow(tEvent.X, tEvent.Y)
+		ti.mFingers[tEvent.FingerID] = &touchFinger{mStart: position, mStartTime: tEvent.Timestamp, mPosition: position}
+
+		//First finger down drives the mouse
+		if !ti.mHasPrimary {
+			ti.mPrimary = tEvent.FingerID
+			ti.mHasPrimary = true
+			return ti.mouseEvents(tEvent.Timestamp, position, sdl.MOUSEBUTTONDOWN)
+		}
+		break
+
+	case sdl.FINGERMOTION:
+		tEvent := e.(*sdl.TouchFingerEvent)
+		finger, ok := ti.mFingers[tEvent.FingerID]
+		if !ok {
+			break
+		}
+
+		finger.mPosition = ti.toWindow(tEvent.X, tEvent.Y)
+		if pointDistance(finger.mStart, finger.mPosition) > touchSlop {
+			finger.mMoved = true
+		}
+
+		if ti.isPrimary(tEvent.FingerID) {
+			return ti.mouseEvents(tEvent.Timestamp, finger.mPosition, sdl.MOUSEMOTION)
+		}
+		break
+
+	case sdl.FINGERUP:
+		tEvent := e.(*sdl.TouchFingerEvent)
+		finger, ok := ti.mFingers[tEvent.FingerID]
+		if !ok {
+			break
+		}
+
+		finger.mPosition = ti.toWindow(tEvent.X, tEvent.Y)
+		if pointDistance(finger.mStart, finger.mPosition) > touchSlop {
+			finger.mMoved = true
+		}
+		delete(ti.mFingers, tEvent.FingerID)
+		ti.recognizeRelease(finger, tEvent.Timestamp)
+
+		if ti.isPrimary(tEvent.FingerID) {
+			ti.mHasPrimary = false
+			return ti.mouseEvents(tEvent.Timestamp, finger.mPosition, sdl.MOUSEBUTTONUP)
+		}
+		break
+
+	case sdl.MULTIGESTURE:
+		gEvent := e.(*sdl.MultiGestureEvent)
+
+		//Pinching and rotating fingers can't tap, press or swipe
+		for _, finger := range ti.mFingers {
+			finger.mMoved = true
+		}
+
+		ti.mPinch += gEvent.DDist
+		ti.mRotation += gEvent.DTheta
+		ti.mGestureAt = ti.toWindow(gEvent.X, gEvent.Y)
+		break
+	}
+
+	return nil
+}
+
+//Update reports long presses for fingers that have been held still long enough
+func (ti *TouchInput) Update(ticks uint32) {
+	for _, finger := range ti.mFingers {
+		if !finger.mMoved && !finger.mLongPressed && ticks-finger.mStartTime >= longPressTime {
+			finger.mLongPressed = true
+			ti.mLongPressed = true
+			ti.mLongPressAt = finger.mPosition
+		}
+	}
+}
+
+//recognizeRelease checks if a lifted finger made a tap or a swipe
+func (ti *TouchInput) recognizeRelease(finger *touchFinger, timestamp uint32) {
+	duration := timestamp - finger.mStartTime
+
+	//Short touch that stayed in place
+	if !finger.mMoved && !finger.mLongPressed && duration < tapTime {
+		ti.mTapped = true
+		ti.mTapAt = finger.mPosition
+		return
+	}
+
+	//Fast long stroke
+	dx := finger.mPosition.X - finger.mStart.X
+	dy := finger.mPosition.Y - finger.mStart.Y
+	if duration < swipeTime && pointDistance(finger.mStart, finger.mPosition) >= swipeDistance {
+		if math.Abs(float64(dx)) > math.Abs(float64(dy)) {
+			if dx < 0 {
+				ti.mSwipe = swipeLeft
+			} else {
+				ti.mSwipe = swipeRight
+			}
+		} else if dy < 0 {
+			ti.mSwipe = swipeUp
+		} else {
+			ti.mSwipe = swipeDown
+		}
+	}
+}
+
+//isPrimary checks if the finger drives the mouse
+func (ti *TouchInput) isPrimary(id sdl.FingerID) bool {
+	return ti.mHasPrimary && ti.mPrimary == id
+}
+
+//toWindow converts normalized touch coordinates to window coordinates
+func (ti *TouchInput) toWindow(x, y float32) sdl.Point {
+	return sdl.Point{X: int32(x * float32(ti.mWidth)), Y: int32(y * float32(ti.mHeight))}
+}
+
+//mouseEvents builds the mouse event matching a primary finger event
+func (ti *TouchInput) mouseEvents(timestamp uint32, position sdl.Point, eventType uint32) []sdl.Event {
+	switch eventType {
+	case sdl.MOUSEMOTION:
+		return []sdl.Event{&sdl.MouseMotionEvent{Type: sdl.MOUSEMOTION, Timestamp: timestamp,
+			Which: sdl.TOUCH_MOUSEID, State: sdl.ButtonLMask(), X: position.X, Y: position.Y}}
+	case sdl.MOUSEBUTTONDOWN:
+		//Move the cursor over the spot before pressing it
+		return []sdl.Event{
+			&sdl.MouseMotionEvent{Type: sdl.MOUSEMOTION, Timestamp: timestamp,
+				Which: sdl.TOUCH_MOUSEID, X: position.X, Y: position.Y},
+			&sdl.MouseButtonEvent{Type: sdl.MOUSEBUTTONDOWN, Timestamp: timestamp, Which: sdl.TOUCH_MOUSEID,
+				Button: sdl.BUTTON_LEFT, State: sdl.PRESSED, Clicks: 1, X: position.X, Y: position.Y},
+		}
+	case sdl.MOUSEBUTTONUP:
+		return []sdl.Event{&sdl.MouseButtonEvent{Type: sdl.MOUSEBUTTONUP, Timestamp: timestamp,
+			Which: sdl.TOUCH_MOUSEID, Button: sdl.BUTTON_LEFT, State: sdl.RELEASED, Clicks: 1,
+			X: position.X, Y: position.Y}}
+	}
+
+	return nil
+}
+
+//FingerCount gets how many fingers are on the screen
+func (ti *TouchInput) FingerCount() int {
+	return len(ti.mFingers)
+}
+
+//Fingers gets the window position of every finger on the screen
+func (ti *TouchInput) Fingers() []sdl.Point {
+	positions := make([]sdl.Point, 0, len(ti.mFingers))
+	for _, finger := range ti.mFingers {
+		positions = append(positions, finger.mPosition)
+	}
+
+	return positions
+}
+
+//Tapped checks if a finger tapped this frame and where
+func (ti *TouchInput) Tapped() (sdl.Point, bool) {
+	return ti.mTapAt, ti.mTapped
+}
+
+//LongPressed checks if a finger was held still long enough this frame and where
+func (ti *TouchInput) LongPressed() (sdl.Point, bool) {
+	return ti.mLongPressAt, ti.mLongPressed
+}
+
+//Swiped gets the direction of a swipe this frame
+func (ti *TouchInput) Swiped() SwipeDirection {
+	return ti.mSwipe
+}
+
+//Pinch gets how much the fingers spread apart this frame, negative when pinching in
+func (ti *TouchInput) Pinch() float32 {
+	return ti.mPinch
+}
+
+//Rotation gets how many radians the fingers rotated this frame
+func (ti *TouchInput) Rotation() float32 {
+	return ti.mRotation
+}
+
+//GestureCenter gets the window position between the pinching or rotating fingers
+func (ti *TouchInput) GestureCenter() sdl.Point {
+	return ti.mGestureAt
+}
+
+//pointDistance gets the chessboard distance between two points
+func pointDistance(a, b sdl.Point) int32 {
+	dx := a.X - b.X
+	if dx < 0 {
+		dx = -dx
+	}
+
+	dy := a.Y - b.Y
+	if dy < 0 {
+		dy = -dy
+	}
+
+	if dx > dy {
+		return dx
+	}
+	return dy
+}
@@ -0,0 +1,249 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//Window size the tests convert touch coordinates with, powers of two so conversions are exact
+const (
+	testTouchWidth  = 1024
+	testTouchHeight = 512
+)
+
+//fingerEvent builds a touch event at window coordinates
+func fingerEvent(eventType uint32, id sdl.FingerID, timestamp uint32, x, y int32) *sdl.TouchFingerEvent {
+	return &sdl.TouchFingerEvent{Type: eventType, Timestamp: timestamp, FingerID: id,
+		X: float32(x) / testTouchWidth, Y: float32(y) / testTouchHeight}
+}
+
+//gestureEvent builds a multi finger gesture event at window coordinates
+func gestureEvent(timestamp uint32, x, y int32, dDist, dTheta float32) *sdl.MultiGestureEvent {
+	return &sdl.MultiGestureEvent{Type: sdl.MULTIGESTURE, Timestamp: timestamp, NumFingers: 2,
+		X: float32(x) / testTouchWidth, Y: float32(y) / testTouchHeight, DDist: dDist, DTheta: dTheta}
+}
+
+//touchStep is one frame fed to the touch input, events first and then the update
+type touchStep struct {
+	mTicks  uint32
+	mEvents []sdl.Event
+}
+
+//runTouchSteps feeds frames to a new touch input and returns it with the synthesized mouse events
+func runTouchSteps(steps []touchStep) (*TouchInput, []sdl.Event) {
+	ti := NewTouchInput(testTouchWidth, testTouchHeight)
+
+	var mouse []sdl.Event
+	for _, step := range steps {
+		ti.NewFrame()
+		for _, e := range step.mEvents {
+			mouse = append(mouse, ti.HandleEvent(e)...)
+		}
+		ti.Update(step.mTicks)
+	}
+
+	return ti, mouse
+}
+
+func TestTouchTap(t *testing.T) {
+	tests := []struct {
+		mName   string
+		mSteps  []touchStep
+		mTapped bool
+	}{
+		{"quick and still", []touchStep{
+			{100, []sdl.Event{fingerEvent(sdl.FINGERDOWN, 1, 100, 200, 100)}},
+			{200, []sdl.Event{fingerEvent(sdl.FINGERUP, 1, 200, 205, 104)}},
+		}, true},
+		{"too slow", []touchStep{
+			{100, []sdl.Event{fingerEvent(sdl.FINGERDOWN, 1, 100, 200, 100)}},
+			{100 + tapTime, []sdl.Event{fingerEvent(sdl.FINGERUP, 1, 100+tapTime, 200, 100)}},
+		}, false},
+		{"moved past the slop", []touchStep{
+			{100, []sdl.Event{fingerEvent(sdl.FINGERDOWN, 1, 100, 200, 100)}},
+			{120, []sdl.Event{fingerEvent(sdl.FINGERMOTION, 1, 120, 230, 100)}},
+			{140, []sdl.Event{fingerEvent(sdl.FINGERUP, 1, 140, 200, 100)}},
+		}, false},
+	}
+
+	for _, test := range tests {
+		ti, _ := runTouchSteps(test.mSteps)
+		at, tapped := ti.Tapped()
+		if tapped != test.mTapped {
+			t.Errorf("%v: tapped %v, want %v", test.mName, tapped, test.mTapped)
+		}
+		if tapped && at != (sdl.Point{X: 205, Y: 104}) {
+			t.Errorf("%v: tapped at %v, want where the finger lifted", test.mName, at)
+		}
+		if ti.FingerCount() != 0 {
+			t.Errorf("%v: %d fingers left on the screen", test.mName, ti.FingerCount())
+		}
+	}
+}
+
+func TestTouchLongPress(t *testing.T) {
+	ti := NewTouchInput(testTouchWidth, testTouchHeight)
+
+	ti.NewFrame()
+	ti.HandleEvent(fingerEvent(sdl.FINGERDOWN, 1, 1000, 300, 200))
+	ti.Update(1000)
+
+	//Not held long enough yet
+	ti.NewFrame()
+	ti.Update(1000 + longPressTime - 1)
+	if _, pressed := ti.LongPressed(); pressed {
+		t.Fatal("long press reported early")
+	}
+
+	//Reported once when the time is up
+	ti.NewFrame()
+	ti.Update(1000 + longPressTime)
+	if at, pressed := ti.LongPressed(); !pressed || at != (sdl.Point{X: 300, Y: 200}) {
+		t.Fatalf("long press = %v at %v, want true at (300, 200)", pressed, at)
+	}
+	ti.NewFrame()
+	ti.Update(1000 + longPressTime*2)
+	if _, pressed := ti.LongPressed(); pressed {
+		t.Error("long press reported twice")
+	}
+
+	//Lifting after a long press isn't a tap
+	ti.NewFrame()
+	ti.HandleEvent(fingerEvent(sdl.FINGERUP, 1, 1000+longPressTime*2, 300, 200))
+	if _, tapped := ti.Tapped(); tapped {
+		t.Error("long press also tapped")
+	}
+
+	//A finger that moved never long presses
+	ti.NewFrame()
+	ti.HandleEvent(fingerEvent(sdl.FINGERDOWN, 2, 5000, 300, 200))
+	ti.HandleEvent(fingerEvent(sdl.FINGERMOTION, 2, 5010, 300, 250))
+	ti.Update(5000 + longPressTime)
+	if _, pressed := ti.LongPressed(); pressed {
+		t.Error("moving finger long pressed")
+	}
+}
+
+func TestTouchSwipe(t *testing.T) {
+	tests := []struct {
+		mName      string
+		mEndX      int32
+		mEndY      int32
+		mDuration  uint32
+		mDirection SwipeDirection
+	}{
+		{"left", 400, 250, 100, swipeLeft},
+		{"right", 600, 260, 100, swipeRight},
+		{"up", 490, 150, 100, swipeUp},
+		{"down", 520, 350, 100, swipeDown},
+		{"too short", 500 + swipeDistance - 1, 250, 100, swipeNone},
+		{"too slow", 700, 250, swipeTime, swipeNone},
+	}
+
+	for _, test := range tests {
+		ti, _ := runTouchSteps([]touchStep{
+			{0, []sdl.Event{fingerEvent(sdl.FINGERDOWN, 1, 0, 500, 250)}},
+			{test.mDuration / 2, []sdl.Event{fingerEvent(sdl.FINGERMOTION, 1, test.mDuration/2,
+				(500+test.mEndX)/2, (250+test.mEndY)/2)}},
+			{test.mDuration, []sdl.Event{fingerEvent(sdl.FINGERUP, 1, test.mDuration, test.mEndX, test.mEndY)}},
+		})
+		if got := ti.Swiped(); got != test.mDirection {
+			t.Errorf("%v: swiped %v, want %v", test.mName, got, test.mDirection)
+		}
+		if _, tapped := ti.Tapped(); tapped {
+			t.Errorf("%v: swipe also tapped", test.mName)
+		}
+	}
+}
+
+func TestTouchPinchAndRotate(t *testing.T) {
+	ti := NewTouchInput(testTouchWidth, testTouchHeight)
+
+	ti.NewFrame()
+	ti.HandleEvent(fingerEvent(sdl.FINGERDOWN, 1, 0, 400, 250))
+	ti.HandleEvent(fingerEvent(sdl.FINGERDOWN, 2, 0, 600, 250))
+
+	//Gestures in one frame add up
+	ti.NewFrame()
+	ti.HandleEvent(gestureEvent(10, 500, 250, 0.02, 0.1))
+	ti.HandleEvent(gestureEvent(20, 500, 250, 0.03, 0.2))
+	ti.Update(20)
+	if pinch := ti.Pinch(); pinch < 0.0499 || pinch > 0.0501 {
+		t.Errorf("pinch = %v, want 0.05", pinch)
+	}
+	if rotation := ti.Rotation(); rotation < 0.2999 || rotation > 0.3001 {
+		t.Errorf("rotation = %v, want 0.3", rotation)
+	}
+	if center := ti.GestureCenter(); center != (sdl.Point{X: 500, Y: 250}) {
+		t.Errorf("gesture center = %v, want (500, 250)", center)
+	}
+
+	//Pinching in and rotating back are negative, and reset every frame
+	ti.NewFrame()
+	ti.HandleEvent(gestureEvent(30, 500, 250, -0.04, -0.5))
+	if ti.Pinch() >= 0 || ti.Rotation() >= 0 {
+		t.Errorf("pinch %v and rotation %v, want both negative", ti.Pinch(), ti.Rotation())
+	}
+
+	//Fingers that gestured can't tap or long press
+	ti.Update(longPressTime * 2)
+	if _, pressed := ti.LongPressed(); pressed {
+		t.Error("gesturing finger long pressed")
+	}
+	ti.NewFrame()
+	ti.HandleEvent(fingerEvent(sdl.FINGERUP, 2, 40, 600, 250))
+	ti.HandleEvent(fingerEvent(sdl.FINGERUP, 1, 40, 400, 250))
+	if _, tapped := ti.Tapped(); tapped {
+		t.Error("gesturing finger tapped")
+	}
+}
+
+func TestTouchMouseEvents(t *testing.T) {
+	_, mouse := runTouchSteps([]touchStep{
+		{0, []sdl.Event{fingerEvent(sdl.FINGERDOWN, 1, 0, 100, 50)}},
+
+		//Second finger and its motion don't drive the mouse
+		{10, []sdl.Event{
+			fingerEvent(sdl.FINGERDOWN, 2, 10, 800, 400),
+			fingerEvent(sdl.FINGERMOTION, 2, 10, 810, 400),
+			fingerEvent(sdl.FINGERMOTION, 1, 10, 120, 60),
+		}},
+		{20, []sdl.Event{fingerEvent(sdl.FINGERUP, 1, 20, 130, 70)}},
+
+		//Once the primary finger is lifted the next new finger takes over
+		{30, []sdl.Event{
+			fingerEvent(sdl.FINGERUP, 2, 30, 810, 400),
+			fingerEvent(sdl.FINGERDOWN, 3, 30, 300, 300),
+		}},
+	})
+
+	want := []sdl.Event{
+		&sdl.MouseMotionEvent{Type: sdl.MOUSEMOTION, Timestamp: 0, Which: sdl.TOUCH_MOUSEID, X: 100, Y: 50},
+		&sdl.MouseButtonEvent{Type: sdl.MOUSEBUTTONDOWN, Timestamp: 0, Which: sdl.TOUCH_MOUSEID,
+			Button: sdl.BUTTON_LEFT, State: sdl.PRESSED, Clicks: 1, X: 100, Y: 50},
+		&sdl.MouseMotionEvent{Type: sdl.MOUSEMOTION, Timestamp: 10, Which: sdl.TOUCH_MOUSEID,
+			State: sdl.ButtonLMask(), X: 120, Y: 60},
+		&sdl.MouseButtonEvent{Type: sdl.MOUSEBUTTONUP, Timestamp: 20, Which: sdl.TOUCH_MOUSEID,
+			Button: sdl.BUTTON_LEFT, State: sdl.RELEASED, Clicks: 1, X: 130, Y: 70},
+		&sdl.MouseMotionEvent{Type: sdl.MOUSEMOTION, Timestamp: 30, Which: sdl.TOUCH_MOUSEID, X: 300, Y: 300},
+		&sdl.MouseButtonEvent{Type: sdl.MOUSEBUTTONDOWN, Timestamp: 30, Which: sdl.TOUCH_MOUSEID,
+			Button: sdl.BUTTON_LEFT, State: sdl.PRESSED, Clicks: 1, X: 300, Y: 300},
+	}
+
+	if len(mouse) != len(want) {
+		t.Fatalf("got %d mouse events, want %d", len(mouse), len(want))
+	}
+	for i := range want {
+		switch w := want[i].(type) {
+		case *sdl.MouseMotionEvent:
+			if got, ok := mouse[i].(*sdl.MouseMotionEvent); !ok || *got != *w {
+				t.Errorf("mouse event %d = %+v, want %+v", i, mouse[i], w)
+			}
+		case *sdl.MouseButtonEvent:
+			if got, ok := mouse[i].(*sdl.MouseButtonEvent); !ok || *got != *w {
+				t.Errorf("mouse event %d = %+v, want %+v", i, mouse[i], w)
+			}
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/veandco/go-sdl2/img"
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/veandco/go-sdl2/ttf"
+)
+
+//Screen dimension constants
+const (
+	screenWitdh  = 640
+	screenHeight = 480
+)
+
+var (
+	//The window we'll be rendering to
+	gWindow *sdl.Window
+
+	//The window renderer
+	gRenderer *sdl.Renderer
+
+	//Globally used font
+	gFont *ttf.Font
+
+	//Mouse Button sprites
+	gSpriteClips              [buttonSpriteTotal]sdl.Rect
+	gButtonSpriteSheetTexture LTexture
+
+	//Button objects
+	gButtons [totalButtons]LButton
+
+	//Touch tracking
+	gTouch *TouchInput
+)
+
+func main() {
+	//Start up SDL and create window
+	if err := initSDl(); err != nil {
+		log.Fatalf("Could not init SDL: %v\n", err)
+	}
+
+	//Load media
+	if err := loadMedia(); err != nil {
+		log.Fatalf("Could not load media: %v\n", err)
+	}
+
+	//Main loop flag
+	quit := false
+
+	//Event handler
+	var e sdl.Event
+
+	//While application is running
+	for !quit {
+		//Forget last frame's gestures
+		gTouch.NewFrame()
+
+		//Handle events on queue
+		for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+			//User requests quit
+			if e.GetType() == sdl.QUIT {
+				quit = true
+			}
+
+			//Push synthetic touches to try gestures without a touch screen
+			if e.GetType() == sdl.KEYDOWN {
+				if err := pushTestGesture((e.(*sdl.KeyboardEvent)).Keysym.Sym); err != nil {
+					fmt.Printf("Warning: %v\n", err)
+				}
+			}
+
+			//Track fingers and get the mouse events they stand for
+			mouseEvents := gTouch.HandleEvent(e)
+
+			//Handle button events
+			for i := 0; i < totalButtons; i++ {
+				gButtons[i].HandleEvent(e)
+				for _, mouseEvent := range mouseEvents {
+					gButtons[i].HandleEvent(mouseEvent)
+				}
+			}
+		}
+
+		//Check for long presses
+		gTouch.Update(sdl.GetTicks())
+
+		//Show recognized gestures in the caption
+		if gesture := describeGesture(gTouch); gesture != "" {
+			gWindow.SetTitle("SDL Tutorial - " + gesture)
+		}
+
+		//Clear screen
+		err := gRenderer.SetDrawColor(255, 255, 255, 255)
+		if err != nil {
+			log.Fatalf("could not set draw color for renderer: %v", err)
+		}
+		err = gRenderer.Clear()
+		if err != nil {
+			log.Fatalf("could not clear renderer: %v", err)
+		}
+
+		//Render buttons
+		for i := 0; i < totalButtons; i++ {
+			err = gButtons[i].Render()
+			if err != nil {
+				log.Fatalf("could not render button %d: %v", i, err)
+			}
+		}
+
+		//Render fingers
+		if err = gRenderer.SetDrawColor(255, 0, 0, 255); err != nil {
+			log.Fatalf("could not set draw color for renderer: %v", err)
+		}
+		for _, finger := range gTouch.Fingers() {
+			fingerRect := sdl.Rect{X: finger.X - 10, Y: finger.Y - 10, W: 20, H: 20}
+			if err = gRenderer.FillRect(&fingerRect); err != nil {
+				log.Fatalf("could not render finger: %v", err)
+			}
+		}
+
+		//Update screen
+		gRenderer.Present()
+	}
+
+	//Free resources and close SDL
+	if err := close(); err != nil {
+		log.Fatalf("Could not close SDL! SDL Error: %v\n", err)
+	}
+}
+
+func initSDl() error {
+	//Local error declaration
+	var err error
+
+	//Initialize SDL
+	if err := sdl.Init(sdl.INIT_VIDEO); err != nil {
+		return fmt.Errorf("SDL could not initialize! SDL_ERROR: %v", err)
+	}
+
+	//Set texture filtering to linear
+	if !sdl.SetHint(sdl.HINT_RENDER_SCALE_QUALITY, "1") {
+		fmt.Printf("Warning: Linear texture filtering not enabled!")
+	}
+
+	//Touch input makes its own mouse events
+	if !sdl.SetHint(sdl.HINT_TOUCH_MOUSE_EVENTS, "0") {
+		fmt.Printf("Warning: Touch mouse events not disabled!")
+	}
+
+	//Create Window
+	gWindow, err = sdl.CreateWindow("SDL Tutorial", sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED,
+		screenWitdh, screenHeight, sdl.WINDOW_SHOWN)
+	if err != nil {
+		return fmt.Errorf("Window could not be created! SDL_Error: %v", err)
+	}
+
+	//Create vsynced renderer for window
+	if gRenderer, err = sdl.CreateRenderer(gWindow, -1, sdl.RENDERER_ACCELERATED|sdl.RENDERER_PRESENTVSYNC); err != nil {
+		return fmt.Errorf("Renderer could not be created! SDL Error: %v", err)
+	}
+
+	//Initialize renderer color
+	gRenderer.SetDrawColor(255, 255, 255, 255)
+
+	//Initialize PNG loading
+	imgFlags := img.INIT_PNG
+	if (img.Init(imgFlags) & imgFlags) == 0 {
+		return fmt.Errorf("SDL_image could not initialize! SDL_image Error: %v", img.GetError())
+	}
+
+	return nil
+}
+
+func loadMedia() error {
+	//Local error declaration
+	var err error
+
+	err = gButtonSpriteSheetTexture.LoadFromFile("button.png")
+	if err != nil {
+		return fmt.Errorf("failed to load button sprite texture: %v", err)
+	}
+
+	//Set sprites
+	for i := 0; i < int(buttonSpriteTotal); i++ {
+		gSpriteClips[i].X = 0
+		gSpriteClips[i].Y = int32(i) * 200
+		gSpriteClips[i].W = buttonWidth
+		gSpriteClips[i].H = buttonHeight
+	}
+
+	//Track touches over the whole window
+	gTouch = NewTouchInput(screenWitdh, screenHeight)
+
+	//Set buttons in corners
+	gButtons[0].SetPosition(0, 0)
+	gButtons[1].SetPosition(screenWitdh-buttonWidth, 0)
+	gButtons[2].SetPosition(0, screenHeight-buttonHeight)
+	gButtons[3].SetPosition(screenWitdh-buttonWidth, screenHeight-buttonHeight)
+
+	return nil
+}
+
+func close() error {
+	//Free loaded images
+	if err := gButtonSpriteSheetTexture.Free(); err != nil {
+		return fmt.Errorf("could not free sprite texture: %v", err)
+	}
+
+	//Destroy window
+	if err := gRenderer.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy global renderer: %v", err)
+	}
+	if err := gWindow.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy window: %v", err)
+	}
+	gWindow = nil
+	gRenderer = nil
+
+	//Quit SDL Subsystems
+	ttf.Quit()
+	img.Quit()
+	sdl.Quit()
+
+	return nil
+}
+
+//Names the gestures recognized this frame
+func describeGesture(touch *TouchInput) string {
+	if at, ok := touch.Tapped(); ok {
+		return fmt.Sprintf("Tap at %d,%d", at.X, at.Y)
+	}
+	if at, ok := touch.LongPressed(); ok {
+		return fmt.Sprintf("Long press at %d,%d", at.X, at.Y)
+	}
+
+	switch touch.Swiped() {
+	case swipeLeft:
+		return "Swipe left"
+	case swipeRight:
+		return "Swipe right"
+	case swipeUp:
+		return "Swipe up"
+	case swipeDown:
+		return "Swipe down"
+	}
+
+	if touch.Pinch() != 0 || touch.Rotation() != 0 {
+		return fmt.Sprintf("Pinch %.3f, rotate %.3f", touch.Pinch(), touch.Rotation())
+	}
+
+	return ""
+}
+
+//Pushes synthetic touch events, T taps the middle of the screen and S swipes right
+func pushTestGesture(key sdl.Keycode) error {
+	var events []sdl.Event
+
+	switch key {
+	case sdl.K_t:
+		events = []sdl.Event{
+			&sdl.TouchFingerEvent{Type: sdl.FINGERDOWN, FingerID: 1, X: 0.5, Y: 0.5, Pressure: 1},
+			&sdl.TouchFingerEvent{Type: sdl.FINGERUP, FingerID: 1, X: 0.5, Y: 0.5},
+		}
+		break
+	case sdl.K_s:
+		events = []sdl.Event{
+			&sdl.TouchFingerEvent{Type: sdl.FINGERDOWN, FingerID: 1, X: 0.2, Y: 0.5, Pressure: 1},
+			&sdl.TouchFingerEvent{Type: sdl.FINGERMOTION, FingerID: 1, X: 0.5, Y: 0.5, DX: 0.3, Pressure: 1},
+			&sdl.TouchFingerEvent{Type: sdl.FINGERUP, FingerID: 1, X: 0.8, Y: 0.5, DX: 0.3},
+		}
+		break
+	}
+
+	for _, e := range events {
+		if _, err := sdl.PushEvent(e); err != nil {
+			return fmt.Errorf("could not push touch event: %v", err)
+		}
+	}
+
+	return nil
+}
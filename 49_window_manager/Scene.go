@@ -0,0 +1,142 @@
+package main
+
+import (
+	"unicode/utf8"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//Scene is the content of a window
+type Scene interface {
+	//HandleEvent handles an event sent to the scene's window
+	HandleEvent(e sdl.Event, w *LWindow) error
+
+	//Render draws the scene with the window's renderer
+	Render(w *LWindow) error
+}
+
+//colorScene fills the window with a color picked with the number pad
+type colorScene struct {
+	mColor sdl.Color
+}
+
+//NewColorScene creates a scene filled with a color
+func NewColorScene(color sdl.Color) Scene {
+	return &colorScene{mColor: color}
+}
+
+//HandleEvent changes the color on R, G and B key presses
+func (cs *colorScene) HandleEvent(e sdl.Event, w *LWindow) error {
+	if e.GetType() == sdl.KEYDOWN {
+		switch (e.(*sdl.KeyboardEvent)).Keysym.Sym {
+		case sdl.K_r:
+			cs.mColor.R += 32
+			break
+		case sdl.K_g:
+			cs.mColor.G += 32
+			break
+		case sdl.K_b:
+			cs.mColor.B += 32
+			break
+		}
+	}
+
+	return nil
+}
+
+//Render fills the window
+func (cs *colorScene) Render(w *LWindow) error {
+	w.Renderer().SetDrawColor(cs.mColor.R, cs.mColor.G, cs.mColor.B, 255)
+	return w.Renderer().FillRect(nil)
+}
+
+//cursorScene draws a square under the mouse and stamps it on clicks
+type cursorScene struct {
+	mCursor sdl.Point
+	mStamps []sdl.Point
+}
+
+//NewCursorScene creates a scene following the mouse
+func NewCursorScene() Scene {
+	return &cursorScene{}
+}
+
+//HandleEvent tracks the mouse inside the window
+func (cs *cursorScene) HandleEvent(e sdl.Event, w *LWindow) error {
+	switch e.GetType() {
+	case sdl.MOUSEMOTION:
+		mEvent := e.(*sdl.MouseMotionEvent)
+		cs.mCursor = sdl.Point{X: mEvent.X, Y: mEvent.Y}
+		break
+
+	case sdl.MOUSEBUTTONDOWN:
+		mEvent := e.(*sdl.MouseButtonEvent)
+		cs.mStamps = append(cs.mStamps, sdl.Point{X: mEvent.X, Y: mEvent.Y})
+		break
+	}
+
+	return nil
+}
+
+//Render draws the stamps and the cursor
+func (cs *cursorScene) Render(w *LWindow) error {
+	w.Renderer().SetDrawColor(0, 0, 255, 255)
+	for _, stamp := range cs.mStamps {
+		if err := w.Renderer().FillRect(&sdl.Rect{X: stamp.X - 5, Y: stamp.Y - 5, W: 10, H: 10}); err != nil {
+			return err
+		}
+	}
+
+	//Only show the cursor while the mouse is over this window
+	if w.HasMouseFocus() {
+		w.Renderer().SetDrawColor(255, 0, 0, 255)
+		return w.Renderer().DrawRect(&sdl.Rect{X: cs.mCursor.X - 10, Y: cs.mCursor.Y - 10, W: 20, H: 20})
+	}
+
+	return nil
+}
+
+//typingScene shows typed text as bars and in the window title
+type typingScene struct {
+	mText string
+}
+
+//NewTypingScene creates a scene taking text input
+func NewTypingScene() Scene {
+	return &typingScene{}
+}
+
+//HandleEvent appends typed text and handles backspace
+func (ts *typingScene) HandleEvent(e sdl.Event, w *LWindow) error {
+	switch e.GetType() {
+	case sdl.TEXTINPUT:
+		ts.mText += (e.(*sdl.TextInputEvent)).GetText()
+		break
+
+	case sdl.KEYDOWN:
+		if (e.(*sdl.KeyboardEvent)).Keysym.Sym == sdl.K_BACKSPACE && len(ts.mText) > 0 {
+			_, size := utf8.DecodeLastRuneInString(ts.mText)
+			ts.mText = ts.mText[:len(ts.mText)-size]
+		}
+		break
+
+	default:
+		return nil
+	}
+
+	w.mWindow.SetTitle(w.Title() + ": " + ts.mText)
+	return nil
+}
+
+//Render draws a bar per typed character
+func (ts *typingScene) Render(w *LWindow) error {
+	w.Renderer().SetDrawColor(0, 0, 0, 255)
+	for i := 0; i < utf8.RuneCountInString(ts.mText); i++ {
+		bar := sdl.Rect{X: int32(10 + (i%40)*15), Y: int32(10 + (i/40)*30), W: 10, H: 20}
+		if err := w.Renderer().FillRect(&bar); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
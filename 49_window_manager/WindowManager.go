@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//managedWindow is a window and the scene shown in it
+type managedWindow struct {
+	mWindow *LWindow
+	mScene  Scene
+}
+
+//WindowManager creates windows, routes events to them and renders their scenes
+type WindowManager struct {
+	//Windows by SDL window id
+	mWindows map[uint32]*managedWindow
+
+	//Window ids in creation order
+	mOrder []uint32
+}
+
+//NewWindowManager initializes a manager with no windows
+func NewWindowManager() *WindowManager {
+	return &WindowManager{mWindows: make(map[uint32]*managedWindow)}
+}
+
+//CreateWindow creates a window and its renderer from config and attaches a scene to it
+func (wm *WindowManager) CreateWindow(config WindowConfig, scene Scene) (*LWindow, error) {
+	window := &LWindow{}
+	if err := window.Init(config); err != nil {
+		return nil, err
+	}
+
+	wm.mWindows[window.ID()] = &managedWindow{mWindow: window, mScene: scene}
+	wm.mOrder = append(wm.mOrder, window.ID())
+
+	return window, nil
+}
+
+//SetScene replaces the scene of a window
+func (wm *WindowManager) SetScene(id uint32, scene Scene) error {
+	mw, ok := wm.mWindows[id]
+	if !ok {
+		return fmt.Errorf("no window with id %d", id)
+	}
+
+	mw.mScene = scene
+	return nil
+}
+
+//Window gets a window by id
+func (wm *WindowManager) Window(id uint32) *LWindow {
+	if mw, ok := wm.mWindows[id]; ok {
+		return mw.mWindow
+	}
+
+	return nil
+}
+
+//Windows gets every open window in creation order
+func (wm *WindowManager) Windows() []*LWindow {
+	windows := make([]*LWindow, 0, len(wm.mOrder))
+	for _, id := range wm.mOrder {
+		windows = append(windows, wm.mWindows[id].mWindow)
+	}
+
+	return windows
+}
+
+//Count gets how many windows are open
+func (wm *WindowManager) Count() int {
+	return len(wm.mWindows)
+}
+
+//HandleEvent sends an event to the window it belongs to and its scene
+//Closed windows are destroyed
+func (wm *WindowManager) HandleEvent(e sdl.Event) error {
+	id, ok := eventWindowID(e)
+	if !ok {
+		return nil
+	}
+
+	mw, ok := wm.mWindows[id]
+	if !ok {
+		return nil
+	}
+
+	if err := mw.mWindow.HandleEvent(e); err != nil {
+		return fmt.Errorf("could not handle event for window %d: %v", id, err)
+	}
+	if mw.mScene != nil {
+		if err := mw.mScene.HandleEvent(e, mw.mWindow); err != nil {
+			return fmt.Errorf("could not handle event for scene of window %d: %v", id, err)
+		}
+	}
+
+	//Window was closed
+	if e.GetType() == sdl.WINDOWEVENT && (e.(*sdl.WindowEvent)).Event == sdl.WINDOWEVENT_CLOSE {
+		return wm.CloseWindow(id)
+	}
+
+	return nil
+}
+
+//Render renders every window's scene
+func (wm *WindowManager) Render() error {
+	for _, id := range wm.mOrder {
+		mw := wm.mWindows[id]
+		if err := mw.mWindow.Render(mw.mScene); err != nil {
+			return fmt.Errorf("could not render window %d: %v", id, err)
+		}
+	}
+
+	return nil
+}
+
+//CloseWindow destroys a window and its renderer
+func (wm *WindowManager) CloseWindow(id uint32) error {
+	mw, ok := wm.mWindows[id]
+	if !ok {
+		return nil
+	}
+
+	delete(wm.mWindows, id)
+	for i, orderID := range wm.mOrder {
+		if orderID == id {
+			wm.mOrder = append(wm.mOrder[:i], wm.mOrder[i+1:]...)
+			break
+		}
+	}
+
+	return mw.mWindow.Free()
+}
+
+//Free destroys every window
+func (wm *WindowManager) Free() error {
+	for len(wm.mOrder) > 0 {
+		if err := wm.CloseWindow(wm.mOrder[0]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//eventWindowID gets the id of the window an event belongs to
+func eventWindowID(e sdl.Event) (uint32, bool) {
+	switch t := e.(type) {
+	case *sdl.WindowEvent:
+		return t.WindowID, true
+	case *sdl.KeyboardEvent:
+		return t.WindowID, true
+	case *sdl.TextEditingEvent:
+		return t.WindowID, true
+	case *sdl.TextInputEvent:
+		return t.WindowID, true
+	case *sdl.MouseMotionEvent:
+		return t.WindowID, true
+	case *sdl.MouseButtonEvent:
+		return t.WindowID, true
+	case *sdl.MouseWheelEvent:
+		return t.WindowID, true
+	case *sdl.DropEvent:
+		return t.WindowID, true
+	case *sdl.UserEvent:
+		return t.WindowID, true
+	}
+
+	return 0, false
+}
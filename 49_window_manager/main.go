@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	//Screen dimension constants
+	screenWitdh  = 640
+	screenHeight = 480
+)
+
+var (
+	//Our window manager
+	gWindowManager *WindowManager
+
+	//Windows to open and the scenes in them
+	gWindowConfigs = []WindowConfig{
+		{Title: "Colors", X: sdl.WINDOWPOS_UNDEFINED, Y: sdl.WINDOWPOS_UNDEFINED,
+			Width: screenWitdh, Height: screenHeight, Flags: sdl.WINDOW_SHOWN | sdl.WINDOW_RESIZABLE},
+		{Title: "Cursor", X: 100, Y: 100,
+			Width: screenWitdh / 2, Height: screenHeight / 2, Flags: sdl.WINDOW_SHOWN},
+		{Title: "Typing", X: sdl.WINDOWPOS_CENTERED, Y: sdl.WINDOWPOS_CENTERED,
+			Width: screenWitdh, Height: screenHeight / 2, Flags: sdl.WINDOW_SHOWN | sdl.WINDOW_RESIZABLE},
+	}
+	gWindowScenes = []Scene{
+		NewColorScene(sdl.Color{R: 255, G: 255, B: 255, A: 255}),
+		NewCursorScene(),
+		NewTypingScene(),
+	}
+)
+
+func main() {
+	//Start up SDL and create windows
+	if err := initSDL(); err != nil {
+		log.Fatalf("Could not init SDL: %v\n", err)
+	}
+
+	//Main loop flag
+	var quit bool
+
+	//Event handler
+	var e sdl.Event
+
+	//Enable text input
+	sdl.StartTextInput()
+
+	//While application is running
+	for !quit {
+		//Handle events on queue
+		for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+			//User requests quit
+			if e.GetType() == sdl.QUIT {
+				quit = true
+			}
+
+			//Send event to its window
+			if err := gWindowManager.HandleEvent(e); err != nil {
+				log.Fatal(err)
+			}
+
+			//Pull up window with control and the number keys, plain number keys are typed
+			if e.GetType() == sdl.KEYDOWN && (e.(*sdl.KeyboardEvent)).Keysym.Mod&sdl.KMOD_CTRL != 0 {
+				index := int((e.(*sdl.KeyboardEvent)).Keysym.Sym - sdl.K_1)
+				if windows := gWindowManager.Windows(); index >= 0 && index < len(windows) {
+					windows[index].Focus()
+				}
+			}
+		}
+
+		//Update all windows
+		if err := gWindowManager.Render(); err != nil {
+			log.Fatal(err)
+		}
+
+		//Application closed all windows
+		if gWindowManager.Count() == 0 {
+			quit = true
+		}
+	}
+
+	//Disable text input
+	sdl.StopTextInput()
+
+	//Free resources and close SDL
+	if err := close(); err != nil {
+		log.Fatalf("Could not close SDL! SDL Error: %v\n", err)
+	}
+}
+
+func initSDL() error {
+	//Initialize SDL
+	if err := sdl.Init(sdl.INIT_VIDEO); err != nil {
+		return fmt.Errorf("SDL could not initialize! SDL_ERROR: %v", err)
+	}
+
+	//Set texture filtering to linear
+	if !sdl.SetHint(sdl.HINT_RENDER_SCALE_QUALITY, "1") {
+		fmt.Printf("Warning: Linear texture filtering not enabled!")
+	}
+
+	//Create windows
+	gWindowManager = NewWindowManager()
+	for i, config := range gWindowConfigs {
+		if _, err := gWindowManager.CreateWindow(config, gWindowScenes[i]); err != nil {
+			return fmt.Errorf("window %d could not be created: %v", i, err)
+		}
+	}
+
+	return nil
+}
+
+func close() error {
+	//Destroy windows
+	if err := gWindowManager.Free(); err != nil {
+		return fmt.Errorf("could not destroy windows: %v", err)
+	}
+
+	sdl.Quit()
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//ScaleMode is how the logical resolution fills the window
+type ScaleMode int
+
+//Scale mode enum
+const (
+	//Fill the whole window, distorting the aspect ratio
+	scaleStretch ScaleMode = iota
+
+	//Largest whole number scale that fits, letterboxed
+	scaleInteger
+
+	//Largest scale that fits keeping the aspect ratio, letterboxed
+	scaleFit
+)
+
+//String gets the scale mode name
+func (sm ScaleMode) String() string {
+	switch sm {
+	case scaleStretch:
+		return "Stretch"
+	case scaleInteger:
+		return "Integer"
+	case scaleFit:
+		return "Fit"
+	}
+
+	return "Unknown"
+}
+
+//LogicalScaler renders a fixed logical resolution scaled to the window
+type LogicalScaler struct {
+	//Logical resolution everything is drawn at
+	mWidth  int32
+	mHeight int32
+
+	mMode ScaleMode
+
+	//Window coordinates to pixels
+	mPixelRatioX float32
+	mPixelRatioY float32
+
+	//Logical coordinates to pixels and where the letterboxed area starts in pixels
+	mScaleX  float32
+	mScaleY  float32
+	mOffsetX float32
+	mOffsetY float32
+}
+
+//NewLogicalScaler creates a scaler for a logical resolution
+func NewLogicalScaler(width, height int32, mode ScaleMode) *LogicalScaler {
+	return &LogicalScaler{mWidth: width, mHeight: height, mMode: mode, mPixelRatioX: 1, mPixelRatioY: 1, mScaleX: 1, mScaleY: 1}
+}
+
+//SetMode changes the scale mode, Apply has to be called after
+func (ls *LogicalScaler) SetMode(mode ScaleMode) {
+	ls.mMode = mode
+}
+
+//Mode gets the scale mode
+func (ls *LogicalScaler) Mode() ScaleMode {
+	return ls.mMode
+}
+
+//Apply sets up the renderer for the window's current size
+//It must be called whenever the window size or the mode changes
+func (ls *LogicalScaler) Apply(renderer *sdl.Renderer, window *LWindow) error {
+	pixelWidth, pixelHeight := float32(window.DrawableWidth()), float32(window.DrawableHeight())
+	if window.MWidth() > 0 && window.MHeight() > 0 {
+		ls.mPixelRatioX = pixelWidth / float32(window.MWidth())
+		ls.mPixelRatioY = pixelHeight / float32(window.MHeight())
+	}
+
+	scaleX := pixelWidth / float32(ls.mWidth)
+	scaleY := pixelHeight / float32(ls.mHeight)
+
+	if ls.mMode == scaleStretch {
+		//Logical size always letterboxes so scale by hand
+		if err := renderer.SetLogicalSize(0, 0); err != nil {
+			return fmt.Errorf("could not reset logical size: %v", err)
+		}
+		if err := renderer.SetScale(scaleX, scaleY); err != nil {
+			return fmt.Errorf("could not set render scale: %v", err)
+		}
+
+		ls.mScaleX, ls.mScaleY = scaleX, scaleY
+		ls.mOffsetX, ls.mOffsetY = 0, 0
+		return nil
+	}
+
+	//Let SDL letterbox
+	if err := renderer.SetIntegerScale(ls.mMode == scaleInteger); err != nil {
+		return fmt.Errorf("could not set integer scale: %v", err)
+	}
+	if err := renderer.SetLogicalSize(ls.mWidth, ls.mHeight); err != nil {
+		return fmt.Errorf("could not set logical size: %v", err)
+	}
+
+	//Same scale SDL picks, kept for converting coordinates
+	scale := float32(math.Min(float64(scaleX), float64(scaleY)))
+	if ls.mMode == scaleInteger {
+		scale = float32(math.Max(1, math.Floor(float64(scale))))
+	}
+	ls.mScaleX, ls.mScaleY = scale, scale
+	ls.mOffsetX = (pixelWidth - float32(ls.mWidth)*scale) / 2
+	ls.mOffsetY = (pixelHeight - float32(ls.mHeight)*scale) / 2
+
+	return nil
+}
+
+//WindowToLogical converts window coordinates to logical coordinates
+//SDL already does this for mouse events, use it for sdl.GetMouseState and other window positions
+func (ls *LogicalScaler) WindowToLogical(x, y int32) sdl.Point {
+	pixelX := float32(x) * ls.mPixelRatioX
+	pixelY := float32(y) * ls.mPixelRatioY
+
+	return sdl.Point{
+		X: int32(math.Floor(float64((pixelX - ls.mOffsetX) / ls.mScaleX))),
+		Y: int32(math.Floor(float64((pixelY - ls.mOffsetY) / ls.mScaleY))),
+	}
+}
+
+//LogicalToWindow converts logical coordinates to window coordinates
+func (ls *LogicalScaler) LogicalToWindow(x, y int32) sdl.Point {
+	return sdl.Point{
+		X: int32((float32(x)*ls.mScaleX + ls.mOffsetX) / ls.mPixelRatioX),
+		Y: int32((float32(y)*ls.mScaleY + ls.mOffsetY) / ls.mPixelRatioY),
+	}
+}
+
+//Contains checks if a logical point is inside the logical resolution
+func (ls *LogicalScaler) Contains(p sdl.Point) bool {
+	return p.X >= 0 && p.Y >= 0 && p.X < ls.mWidth && p.Y < ls.mHeight
+}
+
+//MWidth gets the logical width
+func (ls *LogicalScaler) MWidth() int32 {
+	return ls.mWidth
+}
+
+//MHeight gets the logical height
+func (ls *LogicalScaler) MHeight() int32 {
+	return ls.mHeight
+}
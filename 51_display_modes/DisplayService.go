@@ -0,0 +1,209 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//SDL_DisplayEventID values, not wrapped by go-sdl2
+const (
+	displayEventOrientation  = 1
+	displayEventConnected    = 2
+	displayEventDisconnected = 3
+)
+
+//FullscreenMode is how a window covers a display
+type FullscreenMode int
+
+//Fullscreen mode enum
+const (
+	//Regular window
+	fullscreenOff FullscreenMode = iota
+
+	//Borderless window the size of the desktop, no mode change
+	fullscreenDesktop
+
+	//Exclusive fullscreen that changes the display mode
+	fullscreenExclusive
+)
+
+//String gets the fullscreen mode name
+func (fm FullscreenMode) String() string {
+	switch fm {
+	case fullscreenOff:
+		return "windowed"
+	case fullscreenDesktop:
+		return "desktop"
+	case fullscreenExclusive:
+		return "exclusive"
+	}
+
+	return "unknown"
+}
+
+//DisplayInfo describes a connected display
+type DisplayInfo struct {
+	Name string
+
+	//Full bounds and bounds without task bars and docks
+	Bounds       sdl.Rect
+	UsableBounds sdl.Rect
+
+	//Diagonal, horizontal and vertical DPI, zero when unknown
+	DDPI float32
+	HDPI float32
+	VDPI float32
+
+	//Current desktop mode and every mode the display supports
+	Desktop sdl.DisplayMode
+	Modes   []sdl.DisplayMode
+}
+
+//DisplayService keeps track of the connected displays and switches windows between their modes
+type DisplayService struct {
+	mDisplays []DisplayInfo
+}
+
+//NewDisplayService enumerates the connected displays
+func NewDisplayService() (*DisplayService, error) {
+	ds := &DisplayService{}
+	if err := ds.Refresh(); err != nil {
+		return nil, err
+	}
+
+	return ds, nil
+}
+
+//Refresh enumerates the connected displays again, keeping the previous ones if that fails
+func (ds *DisplayService) Refresh() error {
+	totalDisplays, err := sdl.GetNumVideoDisplays()
+	if err != nil {
+		return fmt.Errorf("could not get number of video displays: %v", err)
+	}
+
+	displays := make([]DisplayInfo, totalDisplays)
+	for i := range displays {
+		d := &displays[i]
+
+		if d.Name, err = sdl.GetDisplayName(i); err != nil {
+			return fmt.Errorf("could not get display %d's name: %v", i, err)
+		}
+		if d.Bounds, err = sdl.GetDisplayBounds(i); err != nil {
+			return fmt.Errorf("could not get display %d's bounds: %v", i, err)
+		}
+		if d.UsableBounds, err = sdl.GetDisplayUsableBounds(i); err != nil {
+			//Not every platform knows, use the full bounds
+			d.UsableBounds = d.Bounds
+		}
+		if d.DDPI, d.HDPI, d.VDPI, err = sdl.GetDisplayDPI(i); err != nil {
+			//Not every platform knows either
+			d.DDPI, d.HDPI, d.VDPI = 0, 0, 0
+		}
+		if d.Desktop, err = sdl.GetDesktopDisplayMode(i); err != nil {
+			return fmt.Errorf("could not get display %d's desktop mode: %v", i, err)
+		}
+
+		//Modes come sorted from largest to smallest
+		totalModes, err := sdl.GetNumDisplayModes(i)
+		if err != nil {
+			return fmt.Errorf("could not get number of display %d's modes: %v", i, err)
+		}
+		d.Modes = make([]sdl.DisplayMode, totalModes)
+		for j := range d.Modes {
+			if d.Modes[j], err = sdl.GetDisplayMode(i, j); err != nil {
+				return fmt.Errorf("could not get display %d's mode %d: %v", i, j, err)
+			}
+		}
+	}
+
+	ds.mDisplays = displays
+	return nil
+}
+
+//HandleEvent refreshes the displays when one is connected, disconnected or rotated
+//It returns true when the displays changed
+func (ds *DisplayService) HandleEvent(e sdl.Event) (bool, error) {
+	if e.GetType() != sdl.DISPLAYEVENT {
+		return false, nil
+	}
+
+	switch (e.(*sdl.DisplayEvent)).Event {
+	case displayEventConnected, displayEventDisconnected, displayEventOrientation:
+		if err := ds.Refresh(); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
+
+//Count gets the number of connected displays
+func (ds *DisplayService) Count() int {
+	return len(ds.mDisplays)
+}
+
+//Display gets a display's info
+func (ds *DisplayService) Display(index int) (DisplayInfo, bool) {
+	if index < 0 || index >= len(ds.mDisplays) {
+		return DisplayInfo{}, false
+	}
+
+	return ds.mDisplays[index], true
+}
+
+//Apply puts a window on the settings' display in the settings' fullscreen mode
+//Settings pointing at a display or mode that's gone are fixed up to what's available
+func (ds *DisplayService) Apply(window *LWindow, settings *VideoSettings) error {
+	if len(ds.mDisplays) == 0 {
+		return fmt.Errorf("no displays connected")
+	}
+
+	//Display was unplugged
+	if settings.mDisplay < 0 || settings.mDisplay >= len(ds.mDisplays) {
+		settings.mDisplay = 0
+	}
+	display := ds.mDisplays[settings.mDisplay]
+
+	//Leave fullscreen first so the window can move between displays
+	if err := window.mWindow.SetFullscreen(0); err != nil {
+		return fmt.Errorf("could not leave fullscreen: %v", err)
+	}
+	window.mFullScreen = false
+
+	//Center on the display's usable area
+	window.mWindow.SetPosition(
+		display.UsableBounds.X+(display.UsableBounds.W-window.MWidth())/2,
+		display.UsableBounds.Y+(display.UsableBounds.H-window.MHeight())/2,
+	)
+
+	switch settings.mFullscreen {
+	case fullscreenDesktop:
+		if err := window.mWindow.SetFullscreen(sdl.WINDOW_FULLSCREEN_DESKTOP); err != nil {
+			return fmt.Errorf("could not set desktop fullscreen: %v", err)
+		}
+		window.mFullScreen = true
+		break
+
+	case fullscreenExclusive:
+		//Pick the supported mode closest to the one asked for
+		wanted := sdl.DisplayMode{W: settings.mWidth, H: settings.mHeight, RefreshRate: settings.mRefreshRate}
+		var closest sdl.DisplayMode
+		if _, err := sdl.GetClosestDisplayMode(settings.mDisplay, &wanted, &closest); err != nil {
+			closest = display.Desktop
+		}
+		settings.mWidth, settings.mHeight, settings.mRefreshRate = closest.W, closest.H, closest.RefreshRate
+
+		if err := window.mWindow.SetDisplayMode(&closest); err != nil {
+			return fmt.Errorf("could not set display mode %dx%d@%d: %v", closest.W, closest.H, closest.RefreshRate, err)
+		}
+		if err := window.mWindow.SetFullscreen(sdl.WINDOW_FULLSCREEN); err != nil {
+			return fmt.Errorf("could not set exclusive fullscreen: %v", err)
+		}
+		window.mFullScreen = true
+		break
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+//VideoSettings is the chosen display and fullscreen mode
+type VideoSettings struct {
+	//Display index
+	mDisplay int
+
+	mFullscreen FullscreenMode
+
+	//Resolution and refresh rate for exclusive fullscreen
+	mWidth       int32
+	mHeight      int32
+	mRefreshRate int32
+}
+
+//NewVideoSettings creates windowed settings on the first display
+func NewVideoSettings() *VideoSettings {
+	return &VideoSettings{mWidth: screenWitdh, mHeight: screenHeight}
+}
+
+//LoadFromFile reads settings saved as "key value" lines
+func (vs *VideoSettings) LoadFromFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("unable to load video settings %v: %v", path, err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return fmt.Errorf("error loading video settings: bad line %d in %v", line, path)
+		}
+
+		value, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return fmt.Errorf("error loading video settings: bad value on line %d in %v: %v", line, path, err)
+		}
+
+		switch fields[0] {
+		case "display":
+			vs.mDisplay = value
+			break
+		case "fullscreen":
+			vs.mFullscreen = FullscreenMode(value)
+			break
+		case "width":
+			vs.mWidth = int32(value)
+			break
+		case "height":
+			vs.mHeight = int32(value)
+			break
+		case "refresh":
+			vs.mRefreshRate = int32(value)
+			break
+		default:
+			fmt.Printf("Warning: Unknown video setting %q on line %d\n", fields[0], line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error loading video settings: %v", err)
+	}
+
+	return nil
+}
+
+//SaveToFile writes settings as "key value" lines
+func (vs *VideoSettings) SaveToFile(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to save video settings %v: %v", path, err)
+	}
+
+	fmt.Fprintf(file, "display %d\n", vs.mDisplay)
+	fmt.Fprintf(file, "fullscreen %d\n", vs.mFullscreen)
+	fmt.Fprintf(file, "width %d\n", vs.mWidth)
+	fmt.Fprintf(file, "height %d\n", vs.mHeight)
+	fmt.Fprintf(file, "refresh %d\n", vs.mRefreshRate)
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("unable to save video settings %v: %v", path, err)
+	}
+
+	return nil
+}
+
+//String describes the settings
+func (vs *VideoSettings) String() string {
+	if vs.mFullscreen == fullscreenExclusive {
+		return fmt.Sprintf("display %d, %v %dx%d@%dHz", vs.mDisplay, vs.mFullscreen, vs.mWidth, vs.mHeight, vs.mRefreshRate)
+	}
+
+	return fmt.Sprintf("display %d, %v", vs.mDisplay, vs.mFullscreen)
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	//Screen dimension constants
+	screenWitdh  = 640
+	screenHeight = 480
+
+	//Where the video settings are kept
+	settingsPath = "video.cfg"
+)
+
+var (
+	//Our custom window
+	gWindow LWindow
+
+	//Display data
+	gDisplays *DisplayService
+
+	//Chosen display and fullscreen mode
+	gSettings = NewVideoSettings()
+)
+
+func main() {
+	//Start up SDL and create window
+	if err := initSDL(); err != nil {
+		log.Fatalf("Could not init SDL: %v\n", err)
+	}
+
+	//Main loop flag
+	var quit bool
+
+	//Event handler
+	var e sdl.Event
+
+	//While application is running
+	for !quit {
+		//Handle events on queue
+		for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+			//User requests quit
+			if e.GetType() == sdl.QUIT {
+				quit = true
+			}
+
+			//Handle window events, displays can vanish mid hotplug so errors aren't fatal
+			if err := gWindow.HandleEvent(e); err != nil {
+				fmt.Printf("Warning: %v\n", err)
+			}
+
+			//Display was plugged in or out, keep the previous displays if they can't be read
+			changed, err := gDisplays.HandleEvent(e)
+			if err != nil {
+				fmt.Printf("Warning: %v\n", err)
+			}
+			if changed {
+				printDisplays()
+				if err := applySettings(); err != nil {
+					fmt.Printf("Warning: %v\n", err)
+				}
+			}
+
+			//Change settings
+			if e.GetType() == sdl.KEYDOWN {
+				if handleSettingsKey((e.(*sdl.KeyboardEvent)).Keysym.Sym) {
+					if err := applySettings(); err != nil {
+						fmt.Printf("Warning: %v\n", err)
+					}
+				}
+			}
+		}
+
+		if err := gWindow.Render(); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	//Remember settings for next time
+	if err := gSettings.SaveToFile(settingsPath); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+
+	//Free resources and close SDL
+	if err := close(); err != nil {
+		log.Fatalf("Could not close SDL! SDL Error: %v\n", err)
+	}
+}
+
+//handleSettingsKey changes the settings on a key press and returns whether they changed
+func handleSettingsKey(key sdl.Keycode) bool {
+	//Every display can be gone for a moment while they're replugged
+	if gDisplays.Count() == 0 {
+		return false
+	}
+
+	switch key {
+	//Cycle through displays up/down
+	case sdl.K_UP:
+		gSettings.mDisplay = (gSettings.mDisplay + 1) % gDisplays.Count()
+		return true
+	case sdl.K_DOWN:
+		gSettings.mDisplay = (gSettings.mDisplay + gDisplays.Count() - 1) % gDisplays.Count()
+		return true
+
+	//Cycle through windowed, desktop fullscreen and exclusive fullscreen
+	case sdl.K_f:
+		gSettings.mFullscreen = (gSettings.mFullscreen + 1) % (fullscreenExclusive + 1)
+		return true
+
+	//Cycle through the display's modes left/right
+	case sdl.K_LEFT, sdl.K_RIGHT:
+		display, ok := gDisplays.Display(gSettings.mDisplay)
+		if !ok || len(display.Modes) == 0 {
+			return false
+		}
+
+		//Find the current mode
+		current := 0
+		for i, mode := range display.Modes {
+			if mode.W == gSettings.mWidth && mode.H == gSettings.mHeight && mode.RefreshRate == gSettings.mRefreshRate {
+				current = i
+				break
+			}
+		}
+
+		//Modes go from largest to smallest
+		if key == sdl.K_LEFT {
+			current = (current + 1) % len(display.Modes)
+		} else {
+			current = (current + len(display.Modes) - 1) % len(display.Modes)
+		}
+
+		mode := display.Modes[current]
+		gSettings.mWidth, gSettings.mHeight, gSettings.mRefreshRate = mode.W, mode.H, mode.RefreshRate
+		gSettings.mFullscreen = fullscreenExclusive
+		return true
+	}
+
+	return false
+}
+
+//applySettings puts the window where the settings say and shows them in the caption
+func applySettings() error {
+	err := gDisplays.Apply(&gWindow, gSettings)
+	gWindow.SetTitle("SDL Tutorial - " + gSettings.String())
+
+	return err
+}
+
+//printDisplays prints every display's info and modes
+func printDisplays() {
+	for i := 0; i < gDisplays.Count(); i++ {
+		display, _ := gDisplays.Display(i)
+		fmt.Printf("Display %d: %v\n", i, display.Name)
+		fmt.Printf("  bounds %v, usable %v\n", display.Bounds, display.UsableBounds)
+		fmt.Printf("  DPI %.1f (%.1f x %.1f)\n", display.DDPI, display.HDPI, display.VDPI)
+		fmt.Printf("  desktop %dx%d@%dHz\n", display.Desktop.W, display.Desktop.H, display.Desktop.RefreshRate)
+		for _, mode := range display.Modes {
+			fmt.Printf("  mode %dx%d@%dHz\n", mode.W, mode.H, mode.RefreshRate)
+		}
+	}
+}
+
+func initSDL() error {
+	var err error
+
+	//Initialize SDL
+	if err := sdl.Init(sdl.INIT_VIDEO); err != nil {
+		return fmt.Errorf("SDL could not initialize! SDL_ERROR: %v", err)
+	}
+
+	//Set texture filtering to linear
+	if !sdl.SetHint(sdl.HINT_RENDER_SCALE_QUALITY, "1") {
+		fmt.Printf("Warning: Linear texture filtering not enabled!")
+	}
+
+	//Get display info
+	if gDisplays, err = NewDisplayService(); err != nil {
+		return err
+	}
+	if gDisplays.Count() < 2 {
+		fmt.Println("Warning! Only one display connected!")
+	}
+	printDisplays()
+
+	//Create Window
+	if err := gWindow.Init(); err != nil {
+		return fmt.Errorf("Window could not be created: %v", err)
+	}
+
+	//Restore last settings
+	if err := gSettings.LoadFromFile(settingsPath); err != nil {
+		fmt.Printf("Warning: %v, using defaults\n", err)
+	}
+	if err := applySettings(); err != nil {
+		fmt.Printf("Warning: %v\n", err)
+	}
+
+	return nil
+}
+
+func close() error {
+	//Destroy window
+	if err := gWindow.Free(); err != nil {
+		return fmt.Errorf("could not destroy window: %v", err)
+	}
+
+	sdl.Quit()
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//Least amount of the window, in screen coordinates, that has to be on a display to grab it
+const windowMinVisible = 64
+
+//WindowState is a window's geometry saved between launches
+type WindowState struct {
+	//Position and size while neither maximized nor fullscreen
+	mBounds sdl.Rect
+
+	//Display the window was on
+	mDisplay int
+
+	mMaximized  bool
+	mFullScreen bool
+}
+
+//NewWindowState creates the state of a window that was never opened
+func NewWindowState() *WindowState {
+	return &WindowState{mBounds: sdl.Rect{X: sdl.WINDOWPOS_UNDEFINED, Y: sdl.WINDOWPOS_UNDEFINED,
+		W: screenWitdh, H: screenHeight}}
+}
+
+//LoadFromFile reads a state saved as "key value" lines
+func (ws *WindowState) LoadFromFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("unable to load window state %v: %v", path, err)
+	}
+	defer file.Close()
+
+	//Read into a copy so a bad file leaves the state alone
+	state := *ws
+
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return fmt.Errorf("error loading window state: bad line %d in %v", line, path)
+		}
+
+		value, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return fmt.Errorf("error loading window state: bad value on line %d in %v: %v", line, path, err)
+		}
+
+		switch fields[0] {
+		case "x":
+			state.mBounds.X = int32(value)
+			break
+		case "y":
+			state.mBounds.Y = int32(value)
+			break
+		case "width":
+			state.mBounds.W = int32(value)
+			break
+		case "height":
+			state.mBounds.H = int32(value)
+			break
+		case "display":
+			state.mDisplay = value
+			break
+		case "maximized":
+			state.mMaximized = value != 0
+			break
+		case "fullscreen":
+			state.mFullScreen = value != 0
+			break
+		default:
+			fmt.Printf("Warning: Unknown window state %q on line %d\n", fields[0], line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error loading window state: %v", err)
+	}
+
+	*ws = state
+	return nil
+}
+
+//SaveToFile writes the state as "key value" lines
+func (ws *WindowState) SaveToFile(path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("unable to save window state %v: %v", path, err)
+	}
+
+	fmt.Fprintf(file, "x %d\n", ws.mBounds.X)
+	fmt.Fprintf(file, "y %d\n", ws.mBounds.Y)
+	fmt.Fprintf(file, "width %d\n", ws.mBounds.W)
+	fmt.Fprintf(file, "height %d\n", ws.mBounds.H)
+	fmt.Fprintf(file, "display %d\n", ws.mDisplay)
+	fmt.Fprintf(file, "maximized %d\n", boolToInt(ws.mMaximized))
+	fmt.Fprintf(file, "fullscreen %d\n", boolToInt(ws.mFullScreen))
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("unable to save window state %v: %v", path, err)
+	}
+
+	return nil
+}
+
+//Validate moves and shrinks the window so it opens on one of the displays
+func (ws *WindowState) Validate(displayBounds []sdl.Rect) {
+	if len(displayBounds) == 0 {
+		return
+	}
+
+	//Saved display was unplugged
+	if ws.mDisplay < 0 || ws.mDisplay >= len(displayBounds) {
+		ws.mDisplay = 0
+	}
+	display := displayBounds[ws.mDisplay]
+
+	//Too small or larger than the display
+	if ws.mBounds.W < windowMinVisible || ws.mBounds.H < windowMinVisible {
+		ws.mBounds.W, ws.mBounds.H = screenWitdh, screenHeight
+	}
+	if ws.mBounds.W > display.W {
+		ws.mBounds.W = display.W
+	}
+	if ws.mBounds.H > display.H {
+		ws.mBounds.H = display.H
+	}
+
+	//Position left for SDL to pick, on the saved display
+	if isWindowPosSpecial(ws.mBounds.X) || isWindowPosSpecial(ws.mBounds.Y) {
+		ws.mBounds.X = windowPosOnDisplay(ws.mBounds.X, ws.mDisplay)
+		ws.mBounds.Y = windowPosOnDisplay(ws.mBounds.Y, ws.mDisplay)
+		return
+	}
+
+	//Keep it where it was if enough of it is still on a display with the title bar reachable
+	for _, bounds := range displayBounds {
+		visible, ok := ws.mBounds.Intersect(&bounds)
+		if ok && visible.W >= windowMinVisible && visible.H >= windowMinVisible &&
+			ws.mBounds.Y >= bounds.Y {
+			return
+		}
+	}
+
+	//Otherwise center it on its display
+	ws.mBounds.X = display.X + (display.W-ws.mBounds.W)/2
+	ws.mBounds.Y = display.Y + (display.H-ws.mBounds.H)/2
+}
+
+//isWindowPosSpecial checks if a coordinate is one of the WINDOWPOS_UNDEFINED/CENTERED values
+func isWindowPosSpecial(pos int32) bool {
+	mask := pos &^ 0xFFFF
+	return mask == sdl.WINDOWPOS_UNDEFINED_MASK || mask == sdl.WINDOWPOS_CENTERED_MASK
+}
+
+//windowPosOnDisplay makes a WINDOWPOS_UNDEFINED/CENTERED coordinate apply to a display, other coordinates are kept
+func windowPosOnDisplay(pos int32, display int) int32 {
+	if !isWindowPosSpecial(pos) {
+		return pos
+	}
+	return pos&^0xFFFF | int32(display)
+}
+
+//boolToInt converts a flag for saving
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
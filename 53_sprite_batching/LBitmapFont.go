@@ -0,0 +1,159 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/img"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//LBitmapFont is 41_bitmap_fonts' font drawn through a sprite batch
+//Every glyph shares the font texture so a whole string is one draw call
+type LBitmapFont struct {
+	//The font texture
+	mBitmap LTexture
+
+	//The individual characters in the surface
+	mChars [256]sdl.Rect
+
+	//Spacing variables
+	mNewLine int32
+	mSpace   int32
+}
+
+//LoadFromFile loads the font image and finds every character in it
+func (bmf *LBitmapFont) LoadFromFile(path string) error {
+	//Textures can't be read back so measure the characters on a surface
+	loadedSurface, err := img.Load(path)
+	if err != nil {
+		return fmt.Errorf("could not load image %v! SDL_image Error: %v", path, err)
+	}
+	defer loadedSurface.Free()
+
+	formattedSurface, err := loadedSurface.ConvertFormat(uint32(sdl.PIXELFORMAT_RGBA32), 0)
+	if err != nil {
+		return fmt.Errorf("could not convert bitmap font surface: %v", err)
+	}
+	defer formattedSurface.Free()
+
+	bmf.buildFont(formattedSurface)
+
+	//Color keyed texture the glyphs are drawn from
+	if err := bmf.mBitmap.LoadFromFile(path); err != nil {
+		return fmt.Errorf("could not load bitmap font texture: %v", err)
+	}
+
+	return nil
+}
+
+//buildFont finds the character boxes in a surface of 16x16 cells
+func (bmf *LBitmapFont) buildFont(surface *sdl.Surface) {
+	//Set the background color
+	bgColor := surface.At(0, 0)
+
+	//Set the cell dimensions
+	cellW := surface.W / 16
+	cellH := surface.H / 16
+
+	//Checks if a pixel is part of a character
+	isInk := func(x, y int32) bool {
+		return surface.At(int(x), int(y)) != bgColor
+	}
+
+	//New line variables
+	top := cellH
+	baseA := cellH
+
+	//Go through the cells
+	for currentChar := 0; currentChar < 256; currentChar++ {
+		cellX := cellW * int32(currentChar%16)
+		cellY := cellH * int32(currentChar/16)
+
+		//Whole cell unless it has ink
+		bmf.mChars[currentChar] = sdl.Rect{X: cellX, Y: cellY, W: cellW, H: cellH}
+
+		//Find the left and right sides
+		left, right := cellW, int32(-1)
+		for pCol := int32(0); pCol < cellW; pCol++ {
+			for pRow := int32(0); pRow < cellH; pRow++ {
+				if isInk(cellX+pCol, cellY+pRow) {
+					if pCol < left {
+						left = pCol
+					}
+					right = pCol
+					break
+				}
+			}
+		}
+		if right >= 0 {
+			bmf.mChars[currentChar].X = cellX + left
+			bmf.mChars[currentChar].W = right - left + 1
+		}
+
+		//Find top and the bottom of A
+		for pRow := int32(0); pRow < cellH; pRow++ {
+			for pCol := int32(0); pCol < cellW; pCol++ {
+				if isInk(cellX+pCol, cellY+pRow) {
+					if pRow < top {
+						top = pRow
+					}
+					if currentChar == 'A' {
+						baseA = pRow
+					}
+					break
+				}
+			}
+		}
+	}
+
+	//Calculate space
+	bmf.mSpace = cellW / 2
+
+	//Calculate new line
+	bmf.mNewLine = baseA - top
+
+	//Lop off excess top pixels
+	for i := 0; i < 256; i++ {
+		bmf.mChars[i].Y += top
+		bmf.mChars[i].H -= top
+	}
+}
+
+//RenderText queues every character of the text in the sprite batch
+func (bmf *LBitmapFont) RenderText(batch *SpriteBatch, x, y int32, text string, color sdl.Color) error {
+	//Temp offsets
+	curX := x
+	curY := y
+
+	//Go through the text
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		//Move over
+		case ' ':
+			curX += bmf.mSpace
+			break
+
+		//Move down and back
+		case '\n':
+			curY += bmf.mNewLine
+			curX = x
+			break
+
+		//Show the character and move over its width with one pixel padding
+		default:
+			ascii := text[i]
+			if err := batch.Draw(&bmf.mBitmap, curX, curY, &bmf.mChars[ascii], color); err != nil {
+				return fmt.Errorf("could not render bitmap font character: %v", err)
+			}
+			curX += bmf.mChars[ascii].W + 1
+			break
+		}
+	}
+
+	return nil
+}
+
+//Free frees the font texture
+func (bmf *LBitmapFont) Free() error {
+	return bmf.mBitmap.Free()
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//TotalParticles is the particle count
+const TotalParticles = 20
+
+//Particle is used to make a little animation that follows the Dot around
+type Particle struct {
+	//Offset
+	mPosX int32
+	mPosY int32
+
+	//Current frame of animation
+	mFrame int
+
+	//Type of particle
+	mTexture *LTexture
+}
+
+//NewParticle initializes position and animation
+func NewParticle(x, y int32) *Particle {
+	p := &Particle{}
+
+	//Set offsets
+	p.mPosX = x - 5 + rand.Int31n(25)
+	p.mPosY = y - 5 + rand.Int31n(25)
+
+	//Initialize animation
+	p.mFrame = rand.Intn(5)
+
+	//Set type
+	switch rand.Intn(3) {
+	case 0:
+		p.mTexture = &gRedTexture
+		break
+	case 1:
+		p.mTexture = &gGreenTexture
+		break
+	case 2:
+		p.mTexture = &gBlueTexture
+		break
+	}
+
+	return p
+}
+
+//Render queues the particle in the sprite batch, fading it out as it ages
+func (p *Particle) Render(batch *SpriteBatch, camera *sdl.Rect) error {
+	//Show image
+	if err := batch.Draw(p.mTexture, p.mPosX-camera.X, p.mPosY-camera.Y, nil, p.fade()); err != nil {
+		return fmt.Errorf("could not show particle image: %v", err)
+	}
+
+	return nil
+}
+
+//RenderShimmer queues the particle's shimmer on every other frame
+//Shimmers are drawn after every particle so they batch together
+func (p *Particle) RenderShimmer(batch *SpriteBatch, camera *sdl.Rect) error {
+	//Show shimmer
+	if p.mFrame%2 == 0 {
+		if err := batch.Draw(&gShimmerTexture, p.mPosX-camera.X, p.mPosY-camera.Y, nil, p.fade()); err != nil {
+			return fmt.Errorf("could not show particle shimmer: %v", err)
+		}
+	}
+
+	return nil
+}
+
+//Animate moves to the next frame
+func (p *Particle) Animate() {
+	p.mFrame++
+}
+
+//fade gets the vertex color that fades the particle out as it ages
+func (p *Particle) fade() sdl.Color {
+	return sdl.Color{R: 255, G: 255, B: 255, A: uint8(192 - p.mFrame*16)}
+}
+
+//IsDead checks if particle is dead
+func (p *Particle) IsDead() bool {
+	return p.mFrame > 10
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//batchSprite is a queued sprite, kept for the Copy fallback
+type batchSprite struct {
+	mClip  sdl.Rect
+	mQuad  sdl.Rect
+	mColor sdl.Color
+}
+
+//SpriteBatch collects sprites sharing a texture and draws them in one call
+type SpriteBatch struct {
+	//Renderer everything is drawn with
+	mRenderer *sdl.Renderer
+
+	//SDL_RenderGeometry is available and turned on
+	mGeometrySupported bool
+	mGeometryEnabled   bool
+
+	//Texture of the sprites waiting to be drawn
+	mTexture *LTexture
+
+	//Queued quads, two triangles each
+	mVertices []sdl.Vertex
+	mIndices  []int32
+
+	//Queued sprites, drawn with Copy when RenderGeometry can't be used
+	mSprites []batchSprite
+
+	//Counters since Begin
+	mDrawCalls   int
+	mSpriteCount int
+}
+
+//NewSpriteBatch creates a batch for a renderer, using RenderGeometry if SDL is new enough
+func NewSpriteBatch(renderer *sdl.Renderer) *SpriteBatch {
+	//RenderGeometry came with SDL 2.0.18
+	var version sdl.Version
+	sdl.GetVersion(&version)
+	supported := sdl.VERSIONNUM(int(version.Major), int(version.Minor), int(version.Patch)) >= sdl.VERSIONNUM(2, 0, 18)
+	if !supported {
+		fmt.Printf("Warning: SDL %d.%d.%d has no RenderGeometry, batching with Copy!\n",
+			version.Major, version.Minor, version.Patch)
+	}
+
+	return &SpriteBatch{mRenderer: renderer, mGeometrySupported: supported, mGeometryEnabled: supported}
+}
+
+//SetGeometryEnabled picks between RenderGeometry and the Copy fallback
+//RenderGeometry can't be turned on if SDL doesn't support it
+func (sb *SpriteBatch) SetGeometryEnabled(enabled bool) error {
+	if err := sb.Flush(); err != nil {
+		return err
+	}
+
+	sb.mGeometryEnabled = enabled && sb.mGeometrySupported
+	return nil
+}
+
+//IsGeometryEnabled checks if the batch draws with RenderGeometry
+func (sb *SpriteBatch) IsGeometryEnabled() bool {
+	return sb.mGeometryEnabled
+}
+
+//Begin resets the counters for a new frame
+func (sb *SpriteBatch) Begin() {
+	sb.mDrawCalls = 0
+	sb.mSpriteCount = 0
+}
+
+//Draw queues a texture clip at a position tinted by a color
+//A nil clip draws the whole texture
+func (sb *SpriteBatch) Draw(texture *LTexture, x, y int32, clip *sdl.Rect, color sdl.Color) error {
+	//Sprites with another texture need their own draw call
+	if texture != sb.mTexture {
+		if err := sb.Flush(); err != nil {
+			return err
+		}
+		sb.mTexture = texture
+	}
+
+	//Set clip rendering dimensions
+	src := sdl.Rect{X: 0, Y: 0, W: texture.mWidth, H: texture.mHeight}
+	if clip != nil {
+		src = *clip
+	}
+	quad := sdl.Rect{X: x, Y: y, W: src.W, H: src.H}
+
+	sb.mSpriteCount++
+	sb.mSprites = append(sb.mSprites, batchSprite{mClip: src, mQuad: quad, mColor: color})
+
+	if !sb.mGeometryEnabled {
+		return nil
+	}
+
+	//Texture coordinates are normalized
+	u0 := float32(src.X) / float32(texture.mWidth)
+	v0 := float32(src.Y) / float32(texture.mHeight)
+	u1 := float32(src.X+src.W) / float32(texture.mWidth)
+	v1 := float32(src.Y+src.H) / float32(texture.mHeight)
+
+	x0, y0 := float32(quad.X), float32(quad.Y)
+	x1, y1 := float32(quad.X+quad.W), float32(quad.Y+quad.H)
+
+	//Top left, top right, bottom right, bottom left
+	first := int32(len(sb.mVertices))
+	sb.mVertices = append(sb.mVertices,
+		sdl.Vertex{Position: sdl.FPoint{X: x0, Y: y0}, Color: color, TexCoord: sdl.FPoint{X: u0, Y: v0}},
+		sdl.Vertex{Position: sdl.FPoint{X: x1, Y: y0}, Color: color, TexCoord: sdl.FPoint{X: u1, Y: v0}},
+		sdl.Vertex{Position: sdl.FPoint{X: x1, Y: y1}, Color: color, TexCoord: sdl.FPoint{X: u1, Y: v1}},
+		sdl.Vertex{Position: sdl.FPoint{X: x0, Y: y1}, Color: color, TexCoord: sdl.FPoint{X: u0, Y: v1}},
+	)
+	sb.mIndices = append(sb.mIndices, first, first+1, first+2, first, first+2, first+3)
+
+	return nil
+}
+
+//Flush draws the queued sprites
+func (sb *SpriteBatch) Flush() error {
+	if sb.mTexture == nil {
+		return nil
+	}
+
+	if len(sb.mVertices) > 0 {
+		err := sb.mRenderer.RenderGeometry(sb.mTexture.mTexture, sb.mVertices, sb.mIndices)
+		sb.mVertices = sb.mVertices[:0]
+		sb.mIndices = sb.mIndices[:0]
+
+		if err == nil {
+			sb.mDrawCalls++
+			sb.mSprites = sb.mSprites[:0]
+			return nil
+		}
+
+		//Don't try again, the renderer can't do it
+		fmt.Printf("Warning: Could not render geometry, falling back to copy! SDL Error: %v\n", err)
+		sb.mGeometrySupported = false
+		sb.mGeometryEnabled = false
+	}
+
+	if len(sb.mSprites) > 0 {
+		return sb.flushCopy()
+	}
+
+	return nil
+}
+
+//flushCopy draws the queued sprites one Copy at a time
+func (sb *SpriteBatch) flushCopy() error {
+	texture := sb.mTexture.mTexture
+
+	for i := range sb.mSprites {
+		sprite := &sb.mSprites[i]
+
+		//Tint sprite
+		if err := texture.SetColorMod(sprite.mColor.R, sprite.mColor.G, sprite.mColor.B); err != nil {
+			return fmt.Errorf("could not set color mod for texture: %v", err)
+		}
+		if err := texture.SetAlphaMod(sprite.mColor.A); err != nil {
+			return fmt.Errorf("could not set alpha mod: %v", err)
+		}
+
+		if err := sb.mRenderer.Copy(texture, &sprite.mClip, &sprite.mQuad); err != nil {
+			return fmt.Errorf("could not copy texture: %v", err)
+		}
+		sb.mDrawCalls++
+	}
+	sb.mSprites = sb.mSprites[:0]
+
+	//Leave the texture untinted
+	texture.SetColorMod(255, 255, 255)
+	texture.SetAlphaMod(255)
+
+	return nil
+}
+
+//End draws whatever is left for the frame
+func (sb *SpriteBatch) End() error {
+	err := sb.Flush()
+	sb.mTexture = nil
+
+	return err
+}
+
+//DrawCalls gets how many draw calls were made since Begin
+func (sb *SpriteBatch) DrawCalls() int {
+	return sb.mDrawCalls
+}
+
+//SpriteCount gets how many sprites were drawn since Begin
+func (sb *SpriteBatch) SpriteCount() int {
+	return sb.mSpriteCount
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//setupBenchmark renders into a surface with the software renderer and loads the scenes
+func setupBenchmark(b *testing.B) []benchmarkScene {
+	b.Helper()
+
+	surface, err := sdl.CreateRGBSurfaceWithFormat(0, screenWitdh, screenHeight, 32, uint32(sdl.PIXELFORMAT_RGBA32))
+	if err != nil {
+		b.Fatalf("could not create target surface: %v", err)
+	}
+	if gRenderer, err = sdl.CreateSoftwareRenderer(surface); err != nil {
+		surface.Free()
+		b.Fatalf("could not create software renderer: %v", err)
+	}
+	gBatch = NewSpriteBatch(gRenderer)
+
+	b.Cleanup(func() {
+		for _, texture := range []*LTexture{&gDotTexture, &gTileTexture, &gRedTexture, &gGreenTexture, &gBlueTexture,
+			&gShimmerTexture} {
+			texture.Free()
+		}
+		gBitmapFont.Free()
+		gRenderer.Destroy()
+		gRenderer = nil
+		gBatch = nil
+		surface.Free()
+	})
+
+	tiles := make([]*Tile, totalTiles)
+	if err := loadMedia(tiles); err != nil {
+		b.Fatal(err)
+	}
+
+	return newBenchmarkScenes(tiles)
+}
+
+//benchmarkScenes times a frame of every scene with and without RenderGeometry
+func benchmarkScenes(b *testing.B, name string) {
+	for _, scene := range setupBenchmark(b) {
+		if scene.mName != name {
+			continue
+		}
+
+		for _, geometry := range []bool{true, false} {
+			mode := "Copy"
+			if geometry {
+				mode = "RenderGeometry"
+			}
+
+			b.Run(mode, func(b *testing.B) {
+				if err := gBatch.SetGeometryEnabled(geometry); err != nil {
+					b.Fatal(err)
+				}
+				if gBatch.IsGeometryEnabled() != geometry {
+					b.Skip("RenderGeometry is not supported")
+				}
+
+				var drawCalls, sprites int
+				for i := 0; i < b.N; i++ {
+					if err := scene.mRender(); err != nil {
+						b.Fatal(err)
+					}
+					drawCalls += gBatch.DrawCalls()
+					sprites += gBatch.SpriteCount()
+				}
+
+				b.ReportMetric(float64(drawCalls)/float64(b.N), "drawcalls/op")
+				b.ReportMetric(float64(sprites)/float64(b.N), "sprites/op")
+			})
+		}
+	}
+}
+
+func BenchmarkTilingMap(b *testing.B) {
+	benchmarkScenes(b, "tiling map")
+}
+
+func BenchmarkParticles(b *testing.B) {
+	benchmarkScenes(b, "particles")
+}
+
+func BenchmarkBitmapFont(b *testing.B) {
+	benchmarkScenes(b, "bitmap font")
+}
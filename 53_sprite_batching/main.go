@@ -0,0 +1,612 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/veandco/go-sdl2/img"
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/veandco/go-sdl2/ttf"
+)
+
+const (
+	//Screen dimension constants
+	screenWitdh  = 640
+	screenHeight = 480
+
+	//The dimensions of the level
+	levelWidth  = 1280
+	levelHeight = 960
+
+	//Tile constants
+	tileWidth        = 80
+	tileHeight       = 80
+	totalTiles       = 192
+	totalTileSprites = 12
+
+	//The different tile sprites
+	tileRed         = 0
+	tileGreen       = 1
+	tileBlue        = 2
+	tileCenter      = 3
+	tileTop         = 4
+	tileTopRight    = 5
+	tileRight       = 6
+	tileBottomRight = 7
+	tileBottom      = 8
+	tileBottomLeft  = 9
+	tileLeft        = 10
+	tileTopLeft     = 11
+
+	//Frames rendered by each benchmark run
+	benchmarkFrames = 1000
+
+	//Grid of dots in the particle benchmark
+	benchmarkDotColumns = 8
+	benchmarkDotRows    = 6
+
+	//Text filling the screen in the bitmap font benchmark
+	benchmarkText = "The quick brown fox\njumps over the lazy\ndog, 0123456789\n"
+)
+
+var (
+	//The window we'll be rendering to
+	gWindow *sdl.Window
+
+	//The window renderer
+	gRenderer *sdl.Renderer
+
+	//Globally used font
+	gFont *ttf.Font
+
+	//Scene textures
+	gDotTexture     LTexture
+	gTileTexture    LTexture
+	gTileClips      [totalTileSprites]sdl.Rect
+	gRedTexture     LTexture
+	gGreenTexture   LTexture
+	gBlueTexture    LTexture
+	gShimmerTexture LTexture
+
+	//Font the counters are drawn with
+	gBitmapFont LBitmapFont
+
+	//Batches the scene's sprites
+	gBatch *SpriteBatch
+
+	//Untinted sprite color
+	colorWhite = sdl.Color{R: 255, G: 255, B: 255, A: 255}
+
+	//Run benchmark instead of the scene
+	benchmark = flag.Bool("bench", false, "render the tiling map, particle and bitmap font scenes with and without RenderGeometry and print timings")
+)
+
+func main() {
+	flag.Parse()
+
+	//Start up SDL and create window
+	if err := initSDl(); err != nil {
+		log.Fatalf("Could not init SDL: %v\n", err)
+	}
+
+	//The level tiles
+	tileSet := make([]*Tile, totalTiles)
+
+	//Load media
+	if err := loadMedia(tileSet); err != nil {
+		log.Fatalf("Could not load media: %v\n", err)
+	}
+
+	//Main loop flag
+	var quit bool
+
+	//Event handler
+	var e sdl.Event
+
+	//The dot that will be moving around on the screen
+	dot := NewDot()
+
+	//Level camera
+	camera := &sdl.Rect{X: 0, Y: 0, W: screenWitdh, H: screenHeight}
+
+	//Time both ways of drawing each scene and leave
+	if *benchmark {
+		for _, scene := range newBenchmarkScenes(tileSet) {
+			for _, geometry := range []bool{true, false} {
+				if err := runBenchmark(scene, geometry); err != nil {
+					log.Fatal(err)
+				}
+			}
+		}
+		quit = true
+	}
+
+	//Counters from the last frame shown on screen
+	var counters string
+
+	//While application is running
+	for !quit {
+		//Handle events on queue
+		for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+			//User requests quit
+			if e.GetType() == sdl.QUIT {
+				quit = true
+			}
+
+			//Toggle RenderGeometry
+			if e.GetType() == sdl.KEYDOWN && (e.(*sdl.KeyboardEvent)).Keysym.Sym == sdl.K_b {
+				if err := gBatch.SetGeometryEnabled(!gBatch.IsGeometryEnabled()); err != nil {
+					log.Fatal(err)
+				}
+			}
+
+			//Handle input for the dot
+			dot.HandleEvent(e)
+		}
+
+		//Move the dot
+		dot.Move(tileSet)
+		dot.SetCamera(camera)
+
+		//Render scene
+		if err := renderScene(tileSet, dot, camera, counters); err != nil {
+			log.Fatal(err)
+		}
+
+		//Counters to show next frame
+		mode := "Copy"
+		if gBatch.IsGeometryEnabled() {
+			mode = "Geometry"
+		}
+		counters = fmt.Sprintf("%v\n%d calls\n%d sprites", mode, gBatch.DrawCalls(), gBatch.SpriteCount())
+	}
+
+	//Free resources and close SDL
+	if err := close(tileSet); err != nil {
+		log.Fatalf("Could not close SDL! SDL Error: %v\n", err)
+	}
+}
+
+//renderScene draws the level, dot and counters through the sprite batch
+func renderScene(tiles []*Tile, dot *Dot, camera *sdl.Rect, counters string) error {
+	return renderFrame(func() error {
+		//Render level
+		if err := renderTiles(tiles, camera); err != nil {
+			return err
+		}
+
+		//Render dot
+		if err := dot.Render(gBatch, camera); err != nil {
+			return err
+		}
+
+		//Render counters, one draw call for all the glyphs
+		return gBitmapFont.RenderText(gBatch, 10, 10, counters, colorWhite)
+	})
+}
+
+//renderFrame clears the screen, queues sprites in the batch and shows them
+func renderFrame(draw func() error) error {
+	//Clear screen
+	err := gRenderer.SetDrawColor(255, 255, 255, 255)
+	if err != nil {
+		return fmt.Errorf("could not set draw color for renderer: %v", err)
+	}
+	err = gRenderer.Clear()
+	if err != nil {
+		return fmt.Errorf("could not clear renderer: %v", err)
+	}
+
+	gBatch.Begin()
+
+	if err = draw(); err != nil {
+		return err
+	}
+
+	//Draw what's left in the batch
+	if err = gBatch.End(); err != nil {
+		return err
+	}
+
+	//Update screen
+	gRenderer.Present()
+
+	return nil
+}
+
+//renderTiles queues the tiles seen by the camera
+func renderTiles(tiles []*Tile, camera *sdl.Rect) error {
+	for i := 0; i < totalTiles; i++ {
+		if err := tiles[i].Render(gBatch, camera); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//benchmarkScene is a scene timed by the benchmark
+type benchmarkScene struct {
+	mName string
+
+	//Renders one frame of the scene
+	mRender func() error
+}
+
+//newBenchmarkScenes creates the tiling map, particle and bitmap font scenes
+func newBenchmarkScenes(tiles []*Tile) []benchmarkScene {
+	//Camera over the middle of the level
+	levelCamera := &sdl.Rect{X: (levelWidth - screenWitdh) / 2, Y: (levelHeight - screenHeight) / 2,
+		W: screenWitdh, H: screenHeight}
+
+	//Grid of dots shedding particles over the screen
+	screenCamera := &sdl.Rect{X: 0, Y: 0, W: screenWitdh, H: screenHeight}
+	dots := make([]*Dot, 0, benchmarkDotColumns*benchmarkDotRows)
+	for row := 0; row < benchmarkDotRows; row++ {
+		for column := 0; column < benchmarkDotColumns; column++ {
+			dot := NewDot()
+			dot.mBox.X = int32((column*2 + 1) * screenWitdh / (benchmarkDotColumns * 2))
+			dot.mBox.Y = int32((row*2 + 1) * screenHeight / (benchmarkDotRows * 2))
+			dots = append(dots, dot)
+		}
+	}
+
+	return []benchmarkScene{
+		{
+			mName: "tiling map",
+			mRender: func() error {
+				return renderFrame(func() error {
+					return renderTiles(tiles, levelCamera)
+				})
+			},
+		},
+		{
+			mName: "particles",
+			mRender: func() error {
+				return renderFrame(func() error {
+					for _, dot := range dots {
+						if err := dot.Render(gBatch, screenCamera); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+			},
+		},
+		{
+			mName: "bitmap font",
+			mRender: func() error {
+				return renderFrame(func() error {
+					return gBitmapFont.RenderText(gBatch, 0, 0, benchmarkText+benchmarkText+benchmarkText, colorWhite)
+				})
+			},
+		},
+	}
+}
+
+//runBenchmark renders a scene a fixed number of frames and prints the time per frame
+func runBenchmark(scene benchmarkScene, geometry bool) error {
+	if err := gBatch.SetGeometryEnabled(geometry); err != nil {
+		return err
+	}
+
+	//Asked for RenderGeometry but SDL doesn't have it
+	mode := "Copy"
+	if gBatch.IsGeometryEnabled() {
+		mode = "RenderGeometry"
+	} else if geometry {
+		fmt.Printf("Skipping %v RenderGeometry benchmark, not supported\n", scene.mName)
+		return nil
+	}
+
+	var timer LTimer
+	timer.Start()
+
+	var drawCalls, sprites int
+	for frame := 0; frame < benchmarkFrames; frame++ {
+		if err := scene.mRender(); err != nil {
+			return err
+		}
+		drawCalls += gBatch.DrawCalls()
+		sprites += gBatch.SpriteCount()
+	}
+
+	elapsed := timer.GetTicks()
+	fmt.Printf("%v, %v: %d frames, %.3f ms/frame, %d draw calls/frame, %d sprites/frame\n", scene.mName, mode,
+		benchmarkFrames, float64(elapsed)/benchmarkFrames, drawCalls/benchmarkFrames, sprites/benchmarkFrames)
+
+	return nil
+}
+
+func initSDl() error {
+	//Local error declaration
+	var err error
+
+	//Initialize SDL
+	if err := sdl.Init(sdl.INIT_VIDEO); err != nil {
+		return fmt.Errorf("SDL could not initialize! SDL_ERROR: %v", err)
+	}
+
+	//Set texture filtering to linear
+	if !sdl.SetHint(sdl.HINT_RENDER_SCALE_QUALITY, "1") {
+		fmt.Printf("Warning: Linear texture filtering not enabled!")
+	}
+
+	//Create Window
+	gWindow, err = sdl.CreateWindow("SDL Tutorial", sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED,
+		screenWitdh, screenHeight, sdl.WINDOW_SHOWN)
+	if err != nil {
+		return fmt.Errorf("Window could not be created! SDL_Error: %v", err)
+	}
+
+	//Create renderer for window, vsynced unless benchmarking
+	rendererFlags := uint32(sdl.RENDERER_ACCELERATED | sdl.RENDERER_PRESENTVSYNC)
+	if *benchmark {
+		rendererFlags = sdl.RENDERER_ACCELERATED
+	}
+	if gRenderer, err = sdl.CreateRenderer(gWindow, -1, rendererFlags); err != nil {
+		return fmt.Errorf("Renderer could not be created! SDL Error: %v", err)
+	}
+
+	//Create sprite batch
+	gBatch = NewSpriteBatch(gRenderer)
+
+	//Initialize renderer color
+	gRenderer.SetDrawColor(255, 255, 255, 255)
+
+	//Initialize PNG loading
+	imgFlags := img.INIT_PNG
+	if (img.Init(imgFlags) & imgFlags) == 0 {
+		return fmt.Errorf("SDL_image could not initialize! SDL_image Error: %v", img.GetError())
+	}
+
+	//Initialize SDL_ttf
+	if err := ttf.Init(); err != nil {
+		return fmt.Errorf("SDL_ttf could not initialize! SDL_ttf Error: %v", err)
+	}
+
+	return nil
+}
+
+func loadMedia(tiles []*Tile) error {
+	var err error
+
+	//Load dot texture
+	if err = gDotTexture.LoadFromFile("dot.bmp"); err != nil {
+		return fmt.Errorf("Failed to load dot texture: %v", err)
+	}
+
+	//Load tile texture
+	if err = gTileTexture.LoadFromFile("tiles.png"); err != nil {
+		return fmt.Errorf("Failed to load tile set texture: %v", err)
+	}
+
+	//Load tile map
+	if err = setTiles(tiles); err != nil {
+		return fmt.Errorf("Failed to load tile set: %v", err)
+	}
+
+	//Load particle textures
+	if err = gRedTexture.LoadFromFile("red.bmp"); err != nil {
+		return fmt.Errorf("Failed to load red texture: %v", err)
+	}
+	if err = gGreenTexture.LoadFromFile("green.bmp"); err != nil {
+		return fmt.Errorf("Failed to load green texture: %v", err)
+	}
+	if err = gBlueTexture.LoadFromFile("blue.bmp"); err != nil {
+		return fmt.Errorf("Failed to load blue texture: %v", err)
+	}
+	if err = gShimmerTexture.LoadFromFile("shimmer.bmp"); err != nil {
+		return fmt.Errorf("Failed to load shimmer texture: %v", err)
+	}
+
+	//Load bitmap font
+	if err = gBitmapFont.LoadFromFile("lazyfont.png"); err != nil {
+		return fmt.Errorf("Failed to load bitmap font: %v", err)
+	}
+
+	return nil
+}
+
+func close(tiles []*Tile) error {
+	//Free loaded images
+	for _, texture := range []*LTexture{&gDotTexture, &gTileTexture, &gRedTexture, &gGreenTexture, &gBlueTexture,
+		&gShimmerTexture} {
+		if err := texture.Free(); err != nil {
+			return fmt.Errorf("could not free texture: %v", err)
+		}
+	}
+	if err := gBitmapFont.Free(); err != nil {
+		return fmt.Errorf("could not free bitmap font: %v", err)
+	}
+
+	//Destroy window
+	if err := gRenderer.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy global renderer: %v", err)
+	}
+	if err := gWindow.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy window: %v", err)
+	}
+	gWindow = nil
+	gRenderer = nil
+
+	//Quit SDL Subsystems
+	ttf.Quit()
+	img.Quit()
+	sdl.Quit()
+
+	return nil
+}
+
+//Box collision detector
+func checkCollision(a, b sdl.Rect) bool {
+	//The sides of the rectangles
+	var (
+		leftA, leftB     int32
+		rightA, rightB   int32
+		topA, topB       int32
+		bottomA, bottomB int32
+	)
+
+	//Calculate the sides of rect A
+	leftA = a.X
+	rightA = a.X + a.W
+	topA = a.Y
+	bottomA = a.Y + a.H
+
+	//Calculate the sides of rect B
+	leftB = b.X
+	rightB = b.X + b.W
+	topB = b.Y
+	bottomB = b.Y + b.H
+
+	//If any of the sides from A are outside of B
+	if bottomA <= topB {
+		return false
+	}
+	if topA >= bottomB {
+		return false
+	}
+	if rightA <= leftB {
+		return false
+	}
+	if leftA >= rightB {
+		return false
+	}
+
+	//If none of the sides from A are outside of B
+	return true
+}
+
+//Checks collision box against set of tiles
+func touchesWall(box sdl.Rect, tiles []*Tile) bool {
+	//Go through tiles
+	for i := 0; i < totalTiles; i++ {
+		//If the tile is a wall type tile
+		if tiles[i].MType() >= tileCenter && tiles[i].MType() <= tileTopLeft {
+			//If collision box touches the wall tile
+			if checkCollision(box, tiles[i].MBox()) {
+				return true
+			}
+		}
+	}
+
+	//If no wall tiles were touched
+	return false
+}
+
+//Sets tiles from tile map
+func setTiles(tiles []*Tile) error {
+	//The tile offsets
+	var x, y int32
+
+	//Open the map
+	mapFile, err := os.Open("lazy.map")
+	if err != nil {
+		return fmt.Errorf("Unable to load map file: %v", err)
+	}
+	defer mapFile.Close()
+
+	//Scanner that will be used to read the tile numbers
+	scanner := bufio.NewScanner(mapFile)
+	scanner.Split(bufio.ScanWords)
+
+	//Initialize the tiles
+	for i := 0; i < totalTiles; i++ {
+		//Determines what kind of tile will be made
+		tileType := -1
+
+		//Read tile from map file
+		scanner.Scan()
+		tileType, err = strconv.Atoi(scanner.Text())
+		if err != nil {
+			return fmt.Errorf("Error loading map: Unexpected EOF")
+		}
+
+		//If the number is valid tile number
+		if tileType >= 0 && tileType < totalTileSprites {
+			tiles[i] = NewTile(x, y, tileType)
+		} else {
+			return fmt.Errorf("Error loading map: Invalid tile type at %d", i)
+		}
+
+		//Move to next tile spot
+		x += tileWidth
+
+		//If we've gone too far
+		if x >= levelWidth {
+			//Move back
+			x = 0
+
+			//Move to the next row
+			y += tileHeight
+		}
+	}
+
+	//Clip the sprite sheet
+	gTileClips[tileRed].X = 0
+	gTileClips[tileRed].Y = 0
+	gTileClips[tileRed].W = tileWidth
+	gTileClips[tileRed].H = tileHeight
+
+	gTileClips[tileGreen].X = 0
+	gTileClips[tileGreen].Y = 80
+	gTileClips[tileGreen].W = tileWidth
+	gTileClips[tileGreen].H = tileHeight
+
+	gTileClips[tileBlue].X = 0
+	gTileClips[tileBlue].Y = 160
+	gTileClips[tileBlue].W = tileWidth
+	gTileClips[tileBlue].H = tileHeight
+
+	gTileClips[tileTopLeft].X = 80
+	gTileClips[tileTopLeft].Y = 0
+	gTileClips[tileTopLeft].W = tileWidth
+	gTileClips[tileTopLeft].H = tileHeight
+
+	gTileClips[tileLeft].X = 80
+	gTileClips[tileLeft].Y = 80
+	gTileClips[tileLeft].W = tileWidth
+	gTileClips[tileLeft].H = tileHeight
+
+	gTileClips[tileBottomLeft].X = 80
+	gTileClips[tileBottomLeft].Y = 160
+	gTileClips[tileBottomLeft].W = tileWidth
+	gTileClips[tileBottomLeft].H = tileHeight
+
+	gTileClips[tileTop].X = 160
+	gTileClips[tileTop].Y = 0
+	gTileClips[tileTop].W = tileWidth
+	gTileClips[tileTop].H = tileHeight
+
+	gTileClips[tileCenter].X = 160
+	gTileClips[tileCenter].Y = 80
+	gTileClips[tileCenter].W = tileWidth
+	gTileClips[tileCenter].H = tileHeight
+
+	gTileClips[tileBottom].X = 160
+	gTileClips[tileBottom].Y = 160
+	gTileClips[tileBottom].W = tileWidth
+	gTileClips[tileBottom].H = tileHeight
+
+	gTileClips[tileTopRight].X = 240
+	gTileClips[tileTopRight].Y = 0
+	gTileClips[tileTopRight].W = tileWidth
+	gTileClips[tileTopRight].H = tileHeight
+
+	gTileClips[tileRight].X = 240
+	gTileClips[tileRight].Y = 80
+	gTileClips[tileRight].W = tileWidth
+	gTileClips[tileRight].H = tileHeight
+
+	gTileClips[tileBottomRight].X = 240
+	gTileClips[tileBottomRight].Y = 160
+	gTileClips[tileBottomRight].W = tileWidth
+	gTileClips[tileBottomRight].H = tileHeight
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//atlasManifest is the JSON written by cmd/atlaspack
+type atlasManifest struct {
+	Image   string `json:"image"`
+	Width   int32  `json:"width"`
+	Height  int32  `json:"height"`
+	Padding int32  `json:"padding"`
+	Extrude int32  `json:"extrude"`
+	Sprites []struct {
+		Name  string `json:"name"`
+		Frame struct {
+			X int32 `json:"x"`
+			Y int32 `json:"y"`
+			W int32 `json:"w"`
+			H int32 `json:"h"`
+		} `json:"frame"`
+		Trimmed bool  `json:"trimmed"`
+		SourceX int32 `json:"sourceX"`
+		SourceY int32 `json:"sourceY"`
+		SourceW int32 `json:"sourceW"`
+		SourceH int32 `json:"sourceH"`
+	} `json:"sprites"`
+}
+
+//AtlasRegion is a named sprite inside an atlas
+type AtlasRegion struct {
+	//Pixels in the atlas texture
+	mClip sdl.Rect
+
+	//Where the clip goes inside the untrimmed sprite
+	mOffset sdl.Point
+
+	//Untrimmed sprite dimensions
+	mSourceWidth  int32
+	mSourceHeight int32
+}
+
+//Clip gets the region's rectangle in the atlas texture
+func (ar AtlasRegion) Clip() sdl.Rect {
+	return ar.mClip
+}
+
+//Offset gets how far the trimmed pixels are from the untrimmed sprite's top left
+func (ar AtlasRegion) Offset() sdl.Point {
+	return ar.mOffset
+}
+
+//SourceWidth gets the untrimmed sprite width
+func (ar AtlasRegion) SourceWidth() int32 {
+	return ar.mSourceWidth
+}
+
+//SourceHeight gets the untrimmed sprite height
+func (ar AtlasRegion) SourceHeight() int32 {
+	return ar.mSourceHeight
+}
+
+//TextureAtlas is a texture holding many named sprites
+type TextureAtlas struct {
+	//The atlas image
+	mTexture LTexture
+
+	//Sprites by name
+	mRegions map[string]AtlasRegion
+}
+
+//LoadFromFile loads a manifest and the atlas image next to it
+func (ta *TextureAtlas) LoadFromFile(path string) error {
+	//Get rid of preexisting atlas
+	if err := ta.Free(); err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to load atlas manifest %v: %v", path, err)
+	}
+
+	var manifest atlasManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return fmt.Errorf("unable to parse atlas manifest %v: %v", path, err)
+	}
+
+	//Image path is relative to the manifest
+	if err := ta.mTexture.LoadFromFile(filepath.Join(filepath.Dir(path), manifest.Image)); err != nil {
+		return fmt.Errorf("unable to load atlas image: %v", err)
+	}
+	if ta.mTexture.GetWidth() != manifest.Width || ta.mTexture.GetHeight() != manifest.Height {
+		fmt.Printf("Warning: Atlas image is %dx%d but manifest says %dx%d!\n",
+			ta.mTexture.GetWidth(), ta.mTexture.GetHeight(), manifest.Width, manifest.Height)
+	}
+
+	ta.mRegions = make(map[string]AtlasRegion, len(manifest.Sprites))
+	for _, s := range manifest.Sprites {
+		if _, ok := ta.mRegions[s.Name]; ok {
+			return fmt.Errorf("error loading atlas manifest %v: duplicate sprite %q", path, s.Name)
+		}
+
+		ta.mRegions[s.Name] = AtlasRegion{
+			mClip:         sdl.Rect{X: s.Frame.X, Y: s.Frame.Y, W: s.Frame.W, H: s.Frame.H},
+			mOffset:       sdl.Point{X: s.SourceX, Y: s.SourceY},
+			mSourceWidth:  s.SourceW,
+			mSourceHeight: s.SourceH,
+		}
+	}
+
+	return nil
+}
+
+//Region gets a named sprite
+func (ta *TextureAtlas) Region(name string) (AtlasRegion, bool) {
+	region, ok := ta.mRegions[name]
+	return region, ok
+}
+
+//Names gets every sprite name in order
+func (ta *TextureAtlas) Names() []string {
+	names := make([]string, 0, len(ta.mRegions))
+	for name := range ta.mRegions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+//Render renders a named sprite with its untrimmed top left at given point
+func (ta *TextureAtlas) Render(name string, x, y int32) error {
+	return ta.RenderScaled(name, x, y, 1)
+}
+
+//RenderScaled renders a named sprite scaled with its untrimmed top left at given point
+func (ta *TextureAtlas) RenderScaled(name string, x, y int32, scale float64) error {
+	region, ok := ta.mRegions[name]
+	if !ok {
+		return fmt.Errorf("no sprite %q in atlas", name)
+	}
+
+	//Trimmed pixels go where they were in the untrimmed sprite
+	renderQuad := sdl.Rect{
+		X: x + int32(float64(region.mOffset.X)*scale),
+		Y: y + int32(float64(region.mOffset.Y)*scale),
+		W: int32(float64(region.mClip.W) * scale),
+		H: int32(float64(region.mClip.H) * scale),
+	}
+
+	if err := gRenderer.Copy(ta.mTexture.mTexture, &region.mClip, &renderQuad); err != nil {
+		return fmt.Errorf("could not copy atlas sprite %q: %v", name, err)
+	}
+
+	return nil
+}
+
+//Free deallocates the atlas
+func (ta *TextureAtlas) Free() error {
+	ta.mRegions = nil
+	return ta.mTexture.Free()
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/veandco/go-sdl2/img"
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/veandco/go-sdl2/ttf"
+)
+
+const (
+	//Screen dimension constants
+	screenWitdh  = 640
+	screenHeight = 480
+
+	//Atlas built by cmd/atlaspack from the sprites folder
+	atlasManifestPath = "atlas.json"
+)
+
+var (
+	//The window we'll be rendering to
+	gWindow *sdl.Window
+
+	//The window renderer
+	gRenderer *sdl.Renderer
+
+	//Globally used font
+	gFont *ttf.Font
+
+	//Scene sprites
+	gAtlas TextureAtlas
+
+	//Tiles shown along the middle of the screen
+	gTileNames = []string{"tile_red", "tile_green", "tile_blue", "tile_top_left", "tile_top", "tile_top_right"}
+)
+
+func main() {
+	//Start up SDL and create window
+	if err := initSDl(); err != nil {
+		log.Fatalf("Could not init SDL: %v\n", err)
+	}
+
+	//Load media
+	if err := loadMedia(); err != nil {
+		log.Fatalf("Could not load media: %v\n", err)
+	}
+
+	//Main loop flag
+	quit := false
+
+	//Event handler
+	var e sdl.Event
+
+	//Tile zoom, the extruded edges keep linear filtering from bleeding neighbours in
+	scale := 1.0
+
+	//While application is running
+	for !quit {
+		//Handle events on queue
+		for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+			//User requests quit
+			if e.GetType() == sdl.QUIT {
+				quit = true
+			}
+
+			//Zoom tiles up/down
+			if e.GetType() == sdl.KEYDOWN {
+				switch (e.(*sdl.KeyboardEvent)).Keysym.Sym {
+				case sdl.K_UP:
+					scale += 0.05
+					break
+				case sdl.K_DOWN:
+					if scale > 0.25 {
+						scale -= 0.05
+					}
+					break
+				}
+			}
+		}
+
+		//Clear screen
+		gRenderer.SetDrawColor(255, 255, 255, 255)
+		gRenderer.Clear()
+
+		//Render dots in the corners
+		dot, _ := gAtlas.Region("dot_red")
+		if err := renderSprites([]string{"dot_red", "dot_green", "dot_yellow", "dot_blue"}, []sdl.Point{
+			{X: 0, Y: 0},
+			{X: screenWitdh - dot.SourceWidth(), Y: 0},
+			{X: 0, Y: screenHeight - dot.SourceHeight()},
+			{X: screenWitdh - dot.SourceWidth(), Y: screenHeight - dot.SourceHeight()},
+		}); err != nil {
+			log.Fatal(err)
+		}
+
+		//Render zoomed tiles side by side across the middle
+		tile, _ := gAtlas.Region(gTileNames[0])
+		tileWidth := int32(float64(tile.SourceWidth()) * scale)
+		x := (screenWitdh - tileWidth*int32(len(gTileNames))) / 2
+		for _, name := range gTileNames {
+			if err := gAtlas.RenderScaled(name, x, screenHeight/2-tileWidth/2, scale); err != nil {
+				log.Fatal(err)
+			}
+			x += tileWidth
+		}
+
+		//Render trimmed foo where the untrimmed image would be, outlining its original size
+		foo, _ := gAtlas.Region("foo")
+		fooBox := sdl.Rect{X: (screenWitdh - foo.SourceWidth()) / 2, Y: 10, W: foo.SourceWidth(), H: foo.SourceHeight()}
+		if err := gAtlas.Render("foo", fooBox.X, fooBox.Y); err != nil {
+			log.Fatal(err)
+		}
+		gRenderer.SetDrawColor(255, 0, 0, 255)
+		gRenderer.DrawRect(&fooBox)
+
+		//Update screen
+		gRenderer.Present()
+	}
+
+	//Free resources and close SDL
+	if err := close(); err != nil {
+		log.Fatalf("Could not close SDL! SDL Error: %v\n", err)
+	}
+}
+
+//renderSprites renders named atlas sprites at matching points
+func renderSprites(names []string, points []sdl.Point) error {
+	for i, name := range names {
+		if err := gAtlas.Render(name, points[i].X, points[i].Y); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func initSDl() error {
+	//Local error declaration
+	var err error
+
+	//Initialize SDL
+	if err := sdl.Init(sdl.INIT_VIDEO); err != nil {
+		return fmt.Errorf("SDL could not initialize! SDL_ERROR: %v", err)
+	}
+
+	//Set texture filtering to linear
+	if !sdl.SetHint(sdl.HINT_RENDER_SCALE_QUALITY, "1") {
+		fmt.Printf("Warning: Linear texture filtering not enabled!")
+	}
+
+	//Create Window
+	gWindow, err = sdl.CreateWindow("SDL Tutorial", sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED,
+		screenWitdh, screenHeight, sdl.WINDOW_SHOWN)
+	if err != nil {
+		return fmt.Errorf("Window could not be created! SDL_Error: %v", err)
+	}
+
+	//Create vsynced renderer for window
+	if gRenderer, err = sdl.CreateRenderer(gWindow, -1, sdl.RENDERER_ACCELERATED|sdl.RENDERER_PRESENTVSYNC); err != nil {
+		return fmt.Errorf("Renderer could not be created! SDL Error: %v", err)
+	}
+
+	//Initialize renderer color
+	gRenderer.SetDrawColor(255, 255, 255, 255)
+
+	//Initialize PNG loading
+	imgFlags := img.INIT_PNG
+	if (img.Init(imgFlags) & imgFlags) == 0 {
+		return fmt.Errorf("SDL_image could not initialize! SDL_image Error: %v", img.GetError())
+	}
+
+	//Initialize SDL_ttf
+	if err := ttf.Init(); err != nil {
+		return fmt.Errorf("SDL_ttf could not initialize! SDL_ttf Error: %v", err)
+	}
+
+	return nil
+}
+
+func loadMedia() error {
+	//Load atlas
+	if err := gAtlas.LoadFromFile(atlasManifestPath); err != nil {
+		return fmt.Errorf("failed to load texture atlas: %v", err)
+	}
+
+	//Make sure every sprite the scene uses was packed
+	for _, name := range append([]string{"dot_red", "dot_green", "dot_yellow", "dot_blue", "foo"}, gTileNames...) {
+		if _, ok := gAtlas.Region(name); !ok {
+			return fmt.Errorf("atlas has no sprite %q", name)
+		}
+	}
+
+	return nil
+}
+
+func close() error {
+	//Free loaded images
+	if err := gAtlas.Free(); err != nil {
+		return fmt.Errorf("could not free texture atlas: %v", err)
+	}
+
+	//Destroy window
+	if err := gRenderer.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy global renderer: %v", err)
+	}
+	if err := gWindow.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy window: %v", err)
+	}
+	gWindow = nil
+	gRenderer = nil
+
+	//Quit SDL Subsystems
+	ttf.Quit()
+	img.Quit()
+	sdl.Quit()
+
+	return nil
+}
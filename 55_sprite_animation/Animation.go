@@ -0,0 +1,102 @@
+package main
+
+import (
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//AnimationMode is what an animation does when it reaches its last frame
+type AnimationMode int
+
+//Animation mode enum
+const (
+	//Start over from the first frame
+	animationLoop AnimationMode = iota
+
+	//Play backwards to the first frame, then forwards again
+	animationPingPong
+
+	//Stop on the last frame
+	animationOnce
+)
+
+//AnimationCallback is called when an animation reaches a frame
+type AnimationCallback func(animation string, frame int)
+
+//AnimationFrame is a clip shown for some time
+type AnimationFrame struct {
+	//Clip in the sprite sheet
+	mClip sdl.Rect
+
+	//Where the clip goes inside the untrimmed frame
+	mOffset sdl.Point
+
+	//How long the frame shows in milliseconds
+	mDuration uint32
+}
+
+//NewAnimationFrame creates an untrimmed frame
+func NewAnimationFrame(clip sdl.Rect, duration uint32) AnimationFrame {
+	return AnimationFrame{mClip: clip, mDuration: duration}
+}
+
+//Animation is a named sequence of frames
+type Animation struct {
+	mName   string
+	mFrames []AnimationFrame
+	mMode   AnimationMode
+
+	//Callbacks by frame index
+	mEvents map[int][]AnimationCallback
+}
+
+//NewAnimation creates an animation from frames
+func NewAnimation(name string, frames []AnimationFrame, mode AnimationMode) *Animation {
+	return &Animation{mName: name, mFrames: frames, mMode: mode, mEvents: make(map[int][]AnimationCallback)}
+}
+
+//AddFrameEvent calls a callback every time the animation reaches a frame
+func (a *Animation) AddFrameEvent(frame int, callback AnimationCallback) {
+	a.mEvents[frame] = append(a.mEvents[frame], callback)
+}
+
+//SetMode changes what happens after the last frame
+func (a *Animation) SetMode(mode AnimationMode) {
+	a.mMode = mode
+}
+
+//Name gets the animation name
+func (a *Animation) Name() string {
+	return a.mName
+}
+
+//Mode gets what happens after the last frame
+func (a *Animation) Mode() AnimationMode {
+	return a.mMode
+}
+
+//FrameCount gets the number of frames
+func (a *Animation) FrameCount() int {
+	return len(a.mFrames)
+}
+
+//Frame gets a frame
+func (a *Animation) Frame(index int) AnimationFrame {
+	return a.mFrames[index]
+}
+
+//Length gets how long one pass over the frames takes in milliseconds
+func (a *Animation) Length() uint32 {
+	var length uint32
+	for _, frame := range a.mFrames {
+		length += frame.mDuration
+	}
+
+	return length
+}
+
+//fireEvents calls a frame's callbacks
+func (a *Animation) fireEvents(frame int) {
+	for _, callback := range a.mEvents[frame] {
+		callback(a.mName, frame)
+	}
+}
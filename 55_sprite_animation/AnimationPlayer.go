@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+)
+
+//AnimationPlayer plays an animation over time, independently of the frame rate
+type AnimationPlayer struct {
+	//Animation playing
+	mAnimation *Animation
+
+	//Current frame and how long it has been showing in milliseconds
+	mFrame   int
+	mElapsed float64
+
+	//1 going forwards, -1 going backwards in ping-pong
+	mDirection int
+
+	//Time multiplier, 1 is normal speed
+	mSpeed float64
+
+	mPaused   bool
+	mFinished bool
+
+	//Called when a play once animation finishes
+	mOnFinished AnimationCallback
+}
+
+//NewAnimationPlayer creates a player at normal speed
+func NewAnimationPlayer() *AnimationPlayer {
+	return &AnimationPlayer{mDirection: 1, mSpeed: 1}
+}
+
+//Play starts an animation from its first frame
+//Playing the animation that's already playing does nothing unless it finished
+func (ap *AnimationPlayer) Play(animation *Animation) {
+	if ap.mAnimation == animation && !ap.mFinished {
+		return
+	}
+
+	ap.Restart(animation)
+}
+
+//Restart starts an animation from its first frame even if it's playing
+func (ap *AnimationPlayer) Restart(animation *Animation) {
+	ap.mAnimation = animation
+	ap.mFrame = 0
+	ap.mElapsed = 0
+	ap.mDirection = 1
+	ap.mFinished = false
+
+	if animation != nil && len(animation.mFrames) > 0 {
+		animation.fireEvents(0)
+	}
+}
+
+//Update advances the animation by the milliseconds since the last update
+func (ap *AnimationPlayer) Update(deltaTicks uint32) {
+	if ap.mAnimation == nil || ap.mPaused || ap.mFinished || len(ap.mAnimation.mFrames) == 0 {
+		return
+	}
+
+	ap.mElapsed += float64(deltaTicks) * ap.mSpeed
+
+	//Long updates can skip several frames
+	for !ap.mFinished {
+		duration := float64(ap.mAnimation.mFrames[ap.mFrame].mDuration)
+		if ap.mElapsed < duration {
+			break
+		}
+
+		//Zero length frames would never let time pass
+		if duration <= 0 {
+			duration = 1
+		}
+
+		ap.mElapsed -= duration
+		ap.advance()
+	}
+}
+
+//advance moves to the next frame according to the animation mode
+func (ap *AnimationPlayer) advance() {
+	frames := len(ap.mAnimation.mFrames)
+	next := ap.mFrame + ap.mDirection
+
+	switch ap.mAnimation.mMode {
+	case animationLoop:
+		if next >= frames {
+			next = 0
+		}
+		break
+
+	case animationPingPong:
+		//Turn around at either end without showing the end frame twice
+		if next >= frames || next < 0 {
+			ap.mDirection = -ap.mDirection
+			next = ap.mFrame + ap.mDirection
+			if next < 0 || next >= frames {
+				next = ap.mFrame
+			}
+		}
+		break
+
+	case animationOnce:
+		if next >= frames {
+			ap.mFinished = true
+			ap.mElapsed = 0
+			if ap.mOnFinished != nil {
+				ap.mOnFinished(ap.mAnimation.mName, ap.mFrame)
+			}
+			return
+		}
+		break
+	}
+
+	ap.mFrame = next
+	ap.mAnimation.fireEvents(next)
+}
+
+//Render renders the current frame from a sprite sheet with the untrimmed frame's top left at given point
+func (ap *AnimationPlayer) Render(texture *LTexture, x, y int32) error {
+	if ap.mAnimation == nil || len(ap.mAnimation.mFrames) == 0 {
+		return nil
+	}
+
+	frame := &ap.mAnimation.mFrames[ap.mFrame]
+	if err := texture.Render(x+frame.mOffset.X, y+frame.mOffset.Y, &frame.mClip); err != nil {
+		return fmt.Errorf("could not render frame %d of %v: %v", ap.mFrame, ap.mAnimation.mName, err)
+	}
+
+	return nil
+}
+
+//SetSpeed sets the time multiplier, 2 plays twice as fast
+func (ap *AnimationPlayer) SetSpeed(speed float64) {
+	if speed < 0 {
+		speed = 0
+	}
+	ap.mSpeed = speed
+}
+
+//Speed gets the time multiplier
+func (ap *AnimationPlayer) Speed() float64 {
+	return ap.mSpeed
+}
+
+//SetOnFinished sets the callback for when a play once animation finishes
+func (ap *AnimationPlayer) SetOnFinished(callback AnimationCallback) {
+	ap.mOnFinished = callback
+}
+
+//Pause stops time for the animation
+func (ap *AnimationPlayer) Pause() {
+	ap.mPaused = true
+}
+
+//Resume lets time pass for the animation again
+func (ap *AnimationPlayer) Resume() {
+	ap.mPaused = false
+}
+
+//IsPaused checks if the animation is paused
+func (ap *AnimationPlayer) IsPaused() bool {
+	return ap.mPaused
+}
+
+//IsFinished checks if a play once animation reached its end
+func (ap *AnimationPlayer) IsFinished() bool {
+	return ap.mFinished
+}
+
+//Animation gets the animation playing
+func (ap *AnimationPlayer) Animation() *Animation {
+	return ap.mAnimation
+}
+
+//CurrentFrame gets the index of the frame showing
+func (ap *AnimationPlayer) CurrentFrame() int {
+	return ap.mFrame
+}
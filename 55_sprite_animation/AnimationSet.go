@@ -0,0 +1,182 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//asepriteRect is a rectangle in an Aseprite export
+type asepriteRect struct {
+	X int32 `json:"x"`
+	Y int32 `json:"y"`
+	W int32 `json:"w"`
+	H int32 `json:"h"`
+}
+
+//asepriteFrame is a frame in an Aseprite export
+type asepriteFrame struct {
+	Frame            asepriteRect `json:"frame"`
+	Trimmed          bool         `json:"trimmed"`
+	SpriteSourceSize asepriteRect `json:"spriteSourceSize"`
+	Duration         uint32       `json:"duration"`
+}
+
+//asepriteExport is the JSON written by Aseprite's "Export Sprite Sheet", as a hash or an array
+type asepriteExport struct {
+	Frames json.RawMessage `json:"frames"`
+	Meta   struct {
+		Image     string `json:"image"`
+		FrameTags []struct {
+			Name      string `json:"name"`
+			From      int    `json:"from"`
+			To        int    `json:"to"`
+			Direction string `json:"direction"`
+			Repeat    string `json:"repeat"`
+		} `json:"frameTags"`
+	} `json:"meta"`
+}
+
+//AnimationSet is the sprite sheet and named animations of a sprite
+type AnimationSet struct {
+	//Sprite sheet image, relative to the working directory
+	mImage string
+
+	//Animations by name
+	mAnimations map[string]*Animation
+}
+
+//LoadAnimationSet loads an Aseprite JSON export
+//Every frame tag becomes an animation, a sprite without tags gets one "default" animation
+func LoadAnimationSet(path string) (*AnimationSet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load animations %v: %v", path, err)
+	}
+
+	var export asepriteExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, fmt.Errorf("unable to parse animations %v: %v", path, err)
+	}
+
+	frames, err := parseAsepriteFrames(export.Frames)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse animations %v: %v", path, err)
+	}
+	if len(frames) == 0 {
+		return nil, fmt.Errorf("error loading animations %v: no frames", path)
+	}
+
+	set := &AnimationSet{
+		mImage:      filepath.Join(filepath.Dir(path), export.Meta.Image),
+		mAnimations: make(map[string]*Animation),
+	}
+
+	//Whole sprite plays as one animation
+	if len(export.Meta.FrameTags) == 0 {
+		set.mAnimations["default"] = NewAnimation("default", frames, animationLoop)
+		return set, nil
+	}
+
+	for _, tag := range export.Meta.FrameTags {
+		if tag.From < 0 || tag.To >= len(frames) || tag.From > tag.To {
+			return nil, fmt.Errorf("error loading animations %v: tag %q goes from %d to %d out of %d frames",
+				path, tag.Name, tag.From, tag.To, len(frames))
+		}
+
+		tagFrames := make([]AnimationFrame, 0, tag.To-tag.From+1)
+		switch tag.Direction {
+		case "reverse":
+			for i := tag.To; i >= tag.From; i-- {
+				tagFrames = append(tagFrames, frames[i])
+			}
+			break
+		default:
+			tagFrames = append(tagFrames, frames[tag.From:tag.To+1]...)
+			break
+		}
+
+		//Ping-pong tags bounce, tags that repeat once stop, everything else loops
+		mode := animationLoop
+		if tag.Direction == "pingpong" {
+			mode = animationPingPong
+		} else if repeat, err := strconv.Atoi(tag.Repeat); err == nil && repeat == 1 {
+			mode = animationOnce
+		}
+
+		set.mAnimations[tag.Name] = NewAnimation(tag.Name, tagFrames, mode)
+	}
+
+	return set, nil
+}
+
+//parseAsepriteFrames reads the frames list or the frames hash in file order
+func parseAsepriteFrames(raw json.RawMessage) ([]AnimationFrame, error) {
+	var exported []asepriteFrame
+
+	raw = bytes.TrimSpace(raw)
+	if len(raw) > 0 && raw[0] == '[' {
+		if err := json.Unmarshal(raw, &exported); err != nil {
+			return nil, err
+		}
+	} else {
+		//Hash keys have to be read one at a time to keep their order
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		if _, err := decoder.Token(); err != nil {
+			return nil, err
+		}
+		for decoder.More() {
+			if _, err := decoder.Token(); err != nil {
+				return nil, err
+			}
+
+			var frame asepriteFrame
+			if err := decoder.Decode(&frame); err != nil {
+				return nil, err
+			}
+			exported = append(exported, frame)
+		}
+	}
+
+	frames := make([]AnimationFrame, len(exported))
+	for i, e := range exported {
+		frames[i] = AnimationFrame{
+			mClip:     sdl.Rect{X: e.Frame.X, Y: e.Frame.Y, W: e.Frame.W, H: e.Frame.H},
+			mDuration: e.Duration,
+		}
+
+		//Trimmed frames keep their place inside the untrimmed frame
+		if e.Trimmed {
+			frames[i].mOffset = sdl.Point{X: e.SpriteSourceSize.X, Y: e.SpriteSourceSize.Y}
+		}
+	}
+
+	return frames, nil
+}
+
+//Animation gets a named animation
+func (as *AnimationSet) Animation(name string) *Animation {
+	return as.mAnimations[name]
+}
+
+//Names gets every animation name in order
+func (as *AnimationSet) Names() []string {
+	names := make([]string, 0, len(as.mAnimations))
+	for name := range as.mAnimations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+//Image gets the sprite sheet path
+func (as *AnimationSet) Image() string {
+	return as.mImage
+}
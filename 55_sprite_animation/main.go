@@ -0,0 +1,228 @@
+package main
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/veandco/go-sdl2/img"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//Screen dimension constants
+const (
+	screenWitdh  = 640
+	screenHeight = 480
+)
+
+var (
+	//The window we'll be rendering to
+	gWindow *sdl.Window
+
+	//The window renderer
+	gRenderer *sdl.Renderer
+
+	//Walking animations
+	gFooAnimations      *AnimationSet
+	gSpriteSheetTexture LTexture
+
+	//Animations picked with the number keys
+	gAnimationKeys = map[sdl.Keycode]string{
+		sdl.K_1: "walk",
+		sdl.K_2: "walk_back",
+		sdl.K_3: "sway",
+		sdl.K_4: "step",
+	}
+)
+
+func main() {
+	//Start up SDL and create window
+	if err := initSDl(); err != nil {
+		log.Fatalf("Could not init SDL: %v\n", err)
+	}
+
+	//Load media
+	if err := loadMedia(); err != nil {
+		log.Fatalf("Could not load media: %v\n", err)
+	}
+
+	//Main loop flag
+	quit := false
+
+	//Event handler
+	var e sdl.Event
+
+	//Steps taken, counted by frame events when a foot lands
+	var steps int
+	countStep := func(animation string, frame int) {
+		steps++
+	}
+	for _, name := range []string{"walk", "walk_back", "step"} {
+		gFooAnimations.Animation(name).AddFrameEvent(1, countStep)
+		gFooAnimations.Animation(name).AddFrameEvent(3, countStep)
+	}
+
+	//Plays foo's animations
+	player := NewAnimationPlayer()
+	player.SetOnFinished(func(animation string, frame int) {
+		fmt.Printf("%v finished on frame %d\n", animation, frame)
+	})
+	player.Play(gFooAnimations.Animation("walk"))
+
+	//Time since last update, the animation runs at the same speed at any frame rate
+	var stepTimer LTimer
+	stepTimer.Start()
+
+	//While application is running
+	for !quit {
+		//Handle events on queue
+		for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+			//User requests quit
+			if e.GetType() == sdl.QUIT {
+				quit = true
+			}
+
+			if e.GetType() == sdl.KEYDOWN {
+				key := (e.(*sdl.KeyboardEvent)).Keysym.Sym
+
+				//Pick animation, restarting play once animations
+				if name, ok := gAnimationKeys[key]; ok {
+					player.Restart(gFooAnimations.Animation(name))
+				}
+
+				switch key {
+				//Speed up/down
+				case sdl.K_UP:
+					player.SetSpeed(player.Speed() + 0.25)
+					break
+				case sdl.K_DOWN:
+					player.SetSpeed(player.Speed() - 0.25)
+					break
+
+				//Pause/resume
+				case sdl.K_SPACE:
+					if player.IsPaused() {
+						player.Resume()
+					} else {
+						player.Pause()
+					}
+					break
+				}
+			}
+		}
+
+		//Animate by the time passed
+		player.Update(stepTimer.GetTicks())
+		stepTimer.Start()
+
+		//Clear screen
+		err := gRenderer.SetDrawColor(255, 255, 255, 255)
+		if err != nil {
+			log.Fatalf("could not set draw color for renderer: %v", err)
+		}
+		err = gRenderer.Clear()
+		if err != nil {
+			log.Fatalf("could not clear renderer: %v", err)
+		}
+
+		//Render current frame
+		frame := player.Animation().Frame(player.CurrentFrame())
+		err = player.Render(&gSpriteSheetTexture, (screenWitdh-frame.mClip.W)/2, (screenHeight-frame.mClip.H)/2)
+		if err != nil {
+			log.Fatalf("could not render sprite sheet texture: %v", err)
+		}
+
+		//Update screen
+		gRenderer.Present()
+
+		//Show animation state
+		gWindow.SetTitle(fmt.Sprintf("SDL Tutorial - %v frame %d, speed %.2fx, %d steps",
+			player.Animation().Name(), player.CurrentFrame(), player.Speed(), steps))
+	}
+
+	//Free resources and close SDL
+	if err := close(); err != nil {
+		log.Fatalf("Could not close SDL! SDL Error: %v\n", err)
+	}
+}
+
+func initSDl() error {
+	//Local error declaration
+	var err error
+
+	//Initialize SDL
+	if err := sdl.Init(sdl.INIT_VIDEO); err != nil {
+		return fmt.Errorf("SDL could not initialize! SDL_ERROR: %v", err)
+	}
+
+	//Set texture filtering to linear
+	if !sdl.SetHint(sdl.HINT_RENDER_SCALE_QUALITY, "1") {
+		fmt.Printf("Warning: Linear texture filtering not enabled!")
+	}
+
+	//Create Window
+	gWindow, err = sdl.CreateWindow("SDL Tutorial", sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED,
+		screenWitdh, screenHeight, sdl.WINDOW_SHOWN)
+	if err != nil {
+		return fmt.Errorf("Window could not be created! SDL_Error: %v", err)
+	}
+
+	//Create vsynced renderer for window
+	if gRenderer, err = sdl.CreateRenderer(gWindow, -1, sdl.RENDERER_ACCELERATED|sdl.RENDERER_PRESENTVSYNC); err != nil {
+		return fmt.Errorf("Renderer could not be created! SDL Error: %v", err)
+	}
+
+	//Initialize renderer color
+	gRenderer.SetDrawColor(255, 255, 255, 255)
+
+	//Initialize PNG loading
+	imgFlags := img.INIT_PNG
+	if (img.Init(imgFlags) & imgFlags) == 0 {
+		return fmt.Errorf("SDL_image could not initialize! SDL_image Error: %v", img.GetError())
+	}
+
+	return nil
+}
+
+func loadMedia() error {
+	var err error
+
+	//Load animations exported from Aseprite
+	if gFooAnimations, err = LoadAnimationSet("foo.json"); err != nil {
+		return fmt.Errorf("failed to load walking animations: %v", err)
+	}
+	for _, name := range gAnimationKeys {
+		if gFooAnimations.Animation(name) == nil {
+			return fmt.Errorf("walking animations have no %q animation", name)
+		}
+	}
+
+	//Load sprite sheet texture
+	if err = gSpriteSheetTexture.LoadFromFile(gFooAnimations.Image()); err != nil {
+		return fmt.Errorf("failed to load walking animation texture: %v", err)
+	}
+
+	return nil
+}
+
+func close() error {
+	//Free loaded images
+	if err := gSpriteSheetTexture.Free(); err != nil {
+		return fmt.Errorf("could not free sprite sheet texture: %v", err)
+	}
+
+	//Destroy window
+	if err := gRenderer.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy global renderer: %v", err)
+	}
+	if err := gWindow.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy window: %v", err)
+	}
+	gWindow = nil
+	gRenderer = nil
+
+	//Quit SDL Subsystems
+	img.Quit()
+	sdl.Quit()
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	//DotWidth is the dot's width
+	DotWidth = 20
+	//DotHeight is the dot's height
+	DotHeight = 20
+
+	//DotVel is the maximum axis velocity of the dot
+	DotVel = 10
+
+	//DotTurn is the rotation step in degrees
+	DotTurn = 15
+
+	//DotZoom is the scale step
+	DotZoom = 0.25
+)
+
+//Dot is the dot that will move around on the screen
+//Its particles and moon are child nodes, so they follow every transform of the dot
+type Dot struct {
+	//The dot's node, placed at the dot's center
+	mNode *SceneNode
+
+	//Group node holding the particles
+	mParticleLayer *SceneNode
+
+	//The particles
+	particles [TotalParticles]*Particle
+
+	//The X and Y offsets of the dot
+	mPosX int32
+	mPosY int32
+
+	//The velocity of the dot
+	mVelX int32
+	mVelY int32
+}
+
+//NewDot builds the dot's node tree
+func NewDot() *Dot {
+	d := &Dot{}
+
+	d.mNode = NewSceneNode("dot", NewSprite(&gDotTexture, nil))
+
+	//Particles are drawn on top of the dot
+	d.mParticleLayer = NewSceneNode("particles", nil)
+	d.mParticleLayer.SetZIndex(1)
+	d.mNode.AddChild(d.mParticleLayer)
+
+	//Initialize particles
+	for i := 0; i < TotalParticles; i++ {
+		d.particles[i] = NewParticle()
+		d.mParticleLayer.AddChild(d.particles[i].Node())
+	}
+
+	//The moon is off to one side so turning and flipping the dot is visible
+	moon := NewSceneNode("moon", NewSprite(&gDotTexture, nil))
+	moon.SetPosition(DotWidth*1.5, 0)
+	moon.SetScale(0.5, 0.5)
+	d.mNode.AddChild(moon)
+
+	d.place()
+
+	return d
+}
+
+//Node gets the root of the dot's node tree
+func (d *Dot) Node() *SceneNode {
+	return d.mNode
+}
+
+//HandleEvent takes keypresses and adjusts the dot's velocity and transform
+func (d *Dot) HandleEvent(e sdl.Event) {
+	//If a key was pressed
+	if e.GetType() == sdl.KEYDOWN && (e.(*sdl.KeyboardEvent)).Repeat == 0 {
+		//Adjust the velocity
+		switch (e.(*sdl.KeyboardEvent)).Keysym.Sym {
+		case sdl.K_UP:
+			d.mVelY -= DotVel
+			break
+		case sdl.K_DOWN:
+			d.mVelY += DotVel
+			break
+		case sdl.K_LEFT:
+			d.mVelX -= DotVel
+			break
+		case sdl.K_RIGHT:
+			d.mVelX += DotVel
+			break
+
+		//Mirror the dot
+		case sdl.K_h:
+			d.mNode.SetFlip(d.mNode.Flip() ^ sdl.FLIP_HORIZONTAL)
+			break
+		case sdl.K_v:
+			d.mNode.SetFlip(d.mNode.Flip() ^ sdl.FLIP_VERTICAL)
+			break
+
+		//Show or hide the particles
+		case sdl.K_p:
+			d.mParticleLayer.SetVisible(!d.mParticleLayer.IsVisible())
+			break
+
+		//Move the particles behind or in front of the dot
+		case sdl.K_z:
+			d.mParticleLayer.SetZIndex(-d.mParticleLayer.ZIndex())
+			break
+		}
+	} else if e.GetType() == sdl.KEYUP && (e.(*sdl.KeyboardEvent)).Repeat == 0 { //If a key was released
+		//Adjust the velocity
+		switch (e.(*sdl.KeyboardEvent)).Keysym.Sym {
+		case sdl.K_UP:
+			d.mVelY += DotVel
+			break
+		case sdl.K_DOWN:
+			d.mVelY -= DotVel
+			break
+		case sdl.K_LEFT:
+			d.mVelX += DotVel
+			break
+		case sdl.K_RIGHT:
+			d.mVelX -= DotVel
+			break
+		}
+	}
+
+	//Turning and zooming repeat while the key is held
+	if e.GetType() == sdl.KEYDOWN {
+		scale, _ := d.mNode.Scale()
+
+		switch (e.(*sdl.KeyboardEvent)).Keysym.Sym {
+		case sdl.K_q:
+			d.mNode.SetRotation(d.mNode.Rotation() - DotTurn)
+			break
+		case sdl.K_e:
+			d.mNode.SetRotation(d.mNode.Rotation() + DotTurn)
+			break
+		case sdl.K_w:
+			if scale < 3 {
+				d.mNode.SetScale(scale+DotZoom, scale+DotZoom)
+			}
+			break
+		case sdl.K_s:
+			if scale > DotZoom {
+				d.mNode.SetScale(scale-DotZoom, scale-DotZoom)
+			}
+			break
+		}
+	}
+}
+
+//Move moves the dot
+func (d *Dot) Move() {
+	//Move the dot left or right
+	d.mPosX += d.mVelX
+
+	//If the dot went too far to the left or right
+	if d.mPosX < 0 || d.mPosX+DotWidth > screenWitdh {
+		//Move back
+		d.mPosX -= d.mVelX
+	}
+
+	//Move the dot up or down
+	d.mPosY += d.mVelY
+
+	//If the dot went too far up or down
+	if d.mPosY < 0 || d.mPosY+DotHeight > screenHeight {
+		//Move back
+		d.mPosY -= d.mVelY
+	}
+
+	d.place()
+}
+
+//Update animates the particles, replacing the dead ones
+func (d *Dot) Update() error {
+	//Go through particles
+	for i := 0; i < TotalParticles; i++ {
+		d.particles[i].Animate()
+
+		//Delete and replace dead particles
+		if d.particles[i].IsDead() {
+			d.mParticleLayer.RemoveChild(d.particles[i].Node())
+			d.particles[i] = NewParticle()
+			if err := d.mParticleLayer.AddChild(d.particles[i].Node()); err != nil {
+				return fmt.Errorf("could not replace particle: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+//String describes the dot's transform
+func (d *Dot) String() string {
+	scale, _ := d.mNode.Scale()
+
+	layer := "front"
+	if d.mParticleLayer.ZIndex() < 0 {
+		layer = "back"
+	}
+	if !d.mParticleLayer.IsVisible() {
+		layer = "hidden"
+	}
+
+	return fmt.Sprintf("Rotation: %.0f Scale: %.2f Flip: %d Particles: %s",
+		d.mNode.Rotation(), scale, d.mNode.Flip(), layer)
+}
+
+//place moves the dot's node to the dot's center
+func (d *Dot) place() {
+	d.mNode.SetPosition(float64(d.mPosX+DotWidth/2), float64(d.mPosY+DotHeight/2))
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"math/rand"
+)
+
+//TotalParticles is the particle count
+const TotalParticles = 20
+
+//Particle is used to make a little animation that follows the Dot around
+type Particle struct {
+	//Node placed relative to the dot's center
+	mNode *SceneNode
+
+	//Shimmer drawn over the particle on every other frame
+	mShimmer *SceneNode
+
+	//Current frame of animation
+	mFrame int
+}
+
+//NewParticle initializes offset and animation
+func NewParticle() *Particle {
+	p := &Particle{}
+
+	//Set type
+	var texture *LTexture
+	switch rand.Intn(3) {
+	case 0:
+		texture = &gRedTexture
+		break
+	case 1:
+		texture = &gGreenTexture
+		break
+	case 2:
+		texture = &gBlueTexture
+		break
+	}
+	p.mNode = NewSceneNode("particle", NewSprite(texture, nil))
+
+	//Set offset around the dot's center
+	p.mNode.SetPosition(float64(rand.Intn(25)-12), float64(rand.Intn(25)-12))
+
+	//Shimmer sits on top of its particle
+	p.mShimmer = NewSceneNode("shimmer", NewSprite(&gShimmerTexture, nil))
+	p.mShimmer.SetZIndex(1)
+	p.mNode.AddChild(p.mShimmer)
+
+	//Initialize animation
+	p.mFrame = rand.Intn(5)
+	p.mShimmer.SetVisible(p.mFrame%2 == 0)
+
+	return p
+}
+
+//Node gets the particle's scene node
+func (p *Particle) Node() *SceneNode {
+	return p.mNode
+}
+
+//Animate moves to the next frame
+func (p *Particle) Animate() {
+	p.mFrame++
+
+	//Show shimmer
+	p.mShimmer.SetVisible(p.mFrame%2 == 0)
+}
+
+//IsDead checks if particle is dead
+func (p *Particle) IsDead() bool {
+	return p.mFrame > 10
+}
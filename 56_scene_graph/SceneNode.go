@@ -0,0 +1,314 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//Transform places a node relative to its parent
+type Transform struct {
+	//Position of the node's origin
+	X float64
+	Y float64
+
+	//Clockwise rotation in degrees around the node's origin
+	Rotation float64
+
+	//Scale factors along each axis
+	ScaleX float64
+	ScaleY float64
+
+	//Mirroring applied before rotation
+	Flip sdl.RendererFlip
+}
+
+//IdentityTransform gets a transform that leaves its children untouched
+func IdentityTransform() Transform {
+	return Transform{ScaleX: 1, ScaleY: 1, Flip: sdl.FLIP_NONE}
+}
+
+//Combine gets the world transform of a child whose local transform is given
+//Scale is applied per axis, so non-uniform scaling doesn't skew rotated children
+func (t Transform) Combine(local Transform) Transform {
+	//Mirror the child's offset and turning direction with the parent
+	x, y, rotation := local.X, local.Y, local.Rotation
+	if t.Flip&sdl.FLIP_HORIZONTAL != 0 {
+		x = -x
+		rotation = -rotation
+	}
+	if t.Flip&sdl.FLIP_VERTICAL != 0 {
+		y = -y
+		rotation = -rotation
+	}
+
+	//Scale the offset
+	x *= t.ScaleX
+	y *= t.ScaleY
+
+	//Rotate the offset around the parent's origin
+	sin, cos := math.Sincos(t.Rotation * math.Pi / 180)
+
+	return Transform{
+		X:        t.X + x*cos - y*sin,
+		Y:        t.Y + x*sin + y*cos,
+		Rotation: t.Rotation + rotation,
+		ScaleX:   t.ScaleX * local.ScaleX,
+		ScaleY:   t.ScaleY * local.ScaleY,
+		Flip:     t.Flip ^ local.Flip,
+	}
+}
+
+//Drawable is something a scene node can show on screen
+type Drawable interface {
+	//Draw renders with the node's world transform
+	Draw(world Transform) error
+}
+
+//SceneNode is an element of the scene tree
+//Nodes move, turn, scale, flip, sort and hide together with their parent
+type SceneNode struct {
+	//Node name for lookups
+	mName string
+
+	//Tree links
+	mParent   *SceneNode
+	mChildren []*SceneNode
+
+	//Transform relative to the parent
+	mLocal Transform
+
+	//Draw order relative to the parent, higher draws on top
+	mZIndex int
+
+	//Hidden nodes hide their whole subtree
+	mVisible bool
+
+	//What is shown at the node's origin, nil for group nodes
+	mDrawable Drawable
+}
+
+//drawItem is a node queued in the draw pass
+type drawItem struct {
+	mNode  *SceneNode
+	mWorld Transform
+	mZ     int
+}
+
+//NewSceneNode initializes a visible node with identity transform
+func NewSceneNode(name string, drawable Drawable) *SceneNode {
+	return &SceneNode{mName: name, mLocal: IdentityTransform(), mVisible: true, mDrawable: drawable}
+}
+
+//AddChild attaches a node, taking it away from its previous parent
+func (n *SceneNode) AddChild(child *SceneNode) error {
+	//A node can't become its own ancestor
+	for ancestor := n; ancestor != nil; ancestor = ancestor.mParent {
+		if ancestor == child {
+			return fmt.Errorf("could not add %q to %q: node would become its own ancestor", child.mName, n.mName)
+		}
+	}
+
+	child.RemoveFromParent()
+	child.mParent = n
+	n.mChildren = append(n.mChildren, child)
+
+	return nil
+}
+
+//RemoveChild detaches a child, returning false if it wasn't one
+func (n *SceneNode) RemoveChild(child *SceneNode) bool {
+	for i, c := range n.mChildren {
+		if c == child {
+			n.mChildren = append(n.mChildren[:i], n.mChildren[i+1:]...)
+			child.mParent = nil
+			return true
+		}
+	}
+
+	return false
+}
+
+//RemoveFromParent detaches the node from the tree
+func (n *SceneNode) RemoveFromParent() {
+	if n.mParent != nil {
+		n.mParent.RemoveChild(n)
+	}
+}
+
+//Find gets the first node in the subtree with the given name
+func (n *SceneNode) Find(name string) *SceneNode {
+	if n.mName == name {
+		return n
+	}
+
+	for _, c := range n.mChildren {
+		if found := c.Find(name); found != nil {
+			return found
+		}
+	}
+
+	return nil
+}
+
+//Name gets the node name
+func (n *SceneNode) Name() string {
+	return n.mName
+}
+
+//Parent gets the parent node, nil for roots
+func (n *SceneNode) Parent() *SceneNode {
+	return n.mParent
+}
+
+//Children gets the child nodes in insertion order
+func (n *SceneNode) Children() []*SceneNode {
+	return n.mChildren
+}
+
+//SetPosition sets the offset from the parent's origin
+func (n *SceneNode) SetPosition(x, y float64) {
+	n.mLocal.X = x
+	n.mLocal.Y = y
+}
+
+//Position gets the offset from the parent's origin
+func (n *SceneNode) Position() (float64, float64) {
+	return n.mLocal.X, n.mLocal.Y
+}
+
+//SetRotation sets the rotation in degrees relative to the parent
+func (n *SceneNode) SetRotation(degrees float64) {
+	n.mLocal.Rotation = math.Mod(degrees, 360)
+}
+
+//Rotation gets the rotation in degrees relative to the parent
+func (n *SceneNode) Rotation() float64 {
+	return n.mLocal.Rotation
+}
+
+//SetScale sets the scale relative to the parent
+func (n *SceneNode) SetScale(x, y float64) {
+	n.mLocal.ScaleX = x
+	n.mLocal.ScaleY = y
+}
+
+//Scale gets the scale relative to the parent
+func (n *SceneNode) Scale() (float64, float64) {
+	return n.mLocal.ScaleX, n.mLocal.ScaleY
+}
+
+//SetFlip sets the mirroring relative to the parent
+func (n *SceneNode) SetFlip(flip sdl.RendererFlip) {
+	n.mLocal.Flip = flip
+}
+
+//Flip gets the mirroring relative to the parent
+func (n *SceneNode) Flip() sdl.RendererFlip {
+	return n.mLocal.Flip
+}
+
+//SetZIndex sets the draw order relative to the parent
+func (n *SceneNode) SetZIndex(z int) {
+	n.mZIndex = z
+}
+
+//ZIndex gets the draw order relative to the parent
+func (n *SceneNode) ZIndex() int {
+	return n.mZIndex
+}
+
+//SetVisible shows or hides the node and its subtree
+func (n *SceneNode) SetVisible(visible bool) {
+	n.mVisible = visible
+}
+
+//IsVisible gets the node's own visibility
+func (n *SceneNode) IsVisible() bool {
+	return n.mVisible
+}
+
+//SetDrawable sets what is shown at the node's origin
+func (n *SceneNode) SetDrawable(drawable Drawable) {
+	n.mDrawable = drawable
+}
+
+//Drawable gets what is shown at the node's origin
+func (n *SceneNode) Drawable() Drawable {
+	return n.mDrawable
+}
+
+//LocalTransform gets the transform relative to the parent
+func (n *SceneNode) LocalTransform() Transform {
+	return n.mLocal
+}
+
+//WorldTransform gets the transform relative to the screen
+func (n *SceneNode) WorldTransform() Transform {
+	if n.mParent == nil {
+		return IdentityTransform().Combine(n.mLocal)
+	}
+
+	return n.mParent.WorldTransform().Combine(n.mLocal)
+}
+
+//WorldZIndex gets the draw order the node is sorted by
+func (n *SceneNode) WorldZIndex() int {
+	z := n.mZIndex
+	for ancestor := n.mParent; ancestor != nil; ancestor = ancestor.mParent {
+		z += ancestor.mZIndex
+	}
+
+	return z
+}
+
+//Render draws the visible part of the subtree sorted by world z-index
+//Nodes with the same z-index keep tree order, so parents draw under their children
+func (n *SceneNode) Render() error {
+	//Start from the parent's placement so subtrees render where they belong
+	var base = IdentityTransform()
+	var baseZ int
+	if n.mParent != nil {
+		base = n.mParent.WorldTransform()
+		baseZ = n.mParent.WorldZIndex()
+	}
+
+	//Gather the drawables
+	var items []drawItem
+	n.collect(base, baseZ, &items)
+
+	//Sort the draw pass
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].mZ < items[j].mZ
+	})
+
+	//Draw back to front
+	for _, item := range items {
+		if err := item.mNode.mDrawable.Draw(item.mWorld); err != nil {
+			return fmt.Errorf("could not draw node %q: %v", item.mNode.mName, err)
+		}
+	}
+
+	return nil
+}
+
+//collect queues visible drawables depth first
+func (n *SceneNode) collect(parent Transform, parentZ int, items *[]drawItem) {
+	//Skip hidden subtrees
+	if !n.mVisible {
+		return
+	}
+
+	world := parent.Combine(n.mLocal)
+	z := parentZ + n.mZIndex
+
+	if n.mDrawable != nil {
+		*items = append(*items, drawItem{mNode: n, mWorld: world, mZ: z})
+	}
+
+	for _, c := range n.mChildren {
+		c.collect(world, z, items)
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//Sprite is a texture region drawn around an origin point
+type Sprite struct {
+	//Texture to show
+	mTexture *LTexture
+
+	//Region of the texture, nil for all of it
+	mClip *sdl.Rect
+
+	//Point of the region placed at the node's origin
+	mOriginX float64
+	mOriginY float64
+}
+
+//NewSprite initializes a sprite centered on its node
+func NewSprite(texture *LTexture, clip *sdl.Rect) *Sprite {
+	s := &Sprite{mTexture: texture, mClip: clip}
+
+	w, h := s.size()
+	s.mOriginX = float64(w) / 2
+	s.mOriginY = float64(h) / 2
+
+	return s
+}
+
+//SetOrigin sets the point of the region placed at the node's origin
+func (s *Sprite) SetOrigin(x, y float64) {
+	s.mOriginX = x
+	s.mOriginY = y
+}
+
+//Draw renders the sprite scaled, flipped and rotated around its origin
+func (s *Sprite) Draw(world Transform) error {
+	w, h := s.size()
+
+	//Negative scale mirrors the sprite
+	flip := world.Flip
+	if world.ScaleX < 0 {
+		flip ^= sdl.FLIP_HORIZONTAL
+	}
+	if world.ScaleY < 0 {
+		flip ^= sdl.FLIP_VERTICAL
+	}
+	scaleX := math.Abs(world.ScaleX)
+	scaleY := math.Abs(world.ScaleY)
+
+	//Mirroring happens inside the destination quad so the origin has to follow it
+	originX := s.mOriginX
+	if flip&sdl.FLIP_HORIZONTAL != 0 {
+		originX = float64(w) - originX
+	}
+	originY := s.mOriginY
+	if flip&sdl.FLIP_VERTICAL != 0 {
+		originY = float64(h) - originY
+	}
+
+	//Set rendering space
+	renderQuad := sdl.Rect{
+		X: int32(math.Round(world.X - originX*scaleX)),
+		Y: int32(math.Round(world.Y - originY*scaleY)),
+		W: int32(math.Round(float64(w) * scaleX)),
+		H: int32(math.Round(float64(h) * scaleY)),
+	}
+	center := sdl.Point{X: int32(math.Round(originX * scaleX)), Y: int32(math.Round(originY * scaleY))}
+
+	//Render to screen
+	if err := gRenderer.CopyEx(s.mTexture.mTexture, s.mClip, &renderQuad, world.Rotation, &center, flip); err != nil {
+		return fmt.Errorf("could not copy sprite: %v", err)
+	}
+
+	return nil
+}
+
+//size gets the dimensions of the region
+func (s *Sprite) size() (int32, int32) {
+	if s.mClip != nil {
+		return s.mClip.W, s.mClip.H
+	}
+
+	return s.mTexture.GetWidth(), s.mTexture.GetHeight()
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//Camera limits
+const (
+	cameraMinZoom = 0.5
+	cameraMaxZoom = 3
+)
+
+//Camera2D follows a target around the level
+//Positions are in world coordinates, the dead zone and shake are in screen pixels
+type Camera2D struct {
+	//Center of the view in the world
+	mX float64
+	mY float64
+
+	//Smoothing velocity
+	mVelX float64
+	mVelY float64
+
+	//Viewport dimensions in screen pixels
+	mViewWidth  int32
+	mViewHeight int32
+
+	//World to screen scale
+	mZoom float64
+
+	//Area around the center the target can move in without moving the camera
+	mDeadZoneWidth  float64
+	mDeadZoneHeight float64
+
+	//Approximate time in seconds the camera takes to catch up, 0 snaps
+	mSmoothTime float64
+
+	//Area the view is kept in, empty for no limits
+	mBounds sdl.Rect
+
+	//Shake strength from 0 to 1 and how much of it is lost per second
+	mTrauma      float64
+	mTraumaDecay float64
+
+	//Largest shake offset in screen pixels
+	mMaxShake float64
+
+	//Current shake offset in screen pixels
+	mShakeX float64
+	mShakeY float64
+}
+
+//NewCamera2D initializes a camera for a viewport
+func NewCamera2D(viewWidth, viewHeight int32) *Camera2D {
+	return &Camera2D{
+		mViewWidth:   viewWidth,
+		mViewHeight:  viewHeight,
+		mZoom:        1,
+		mSmoothTime:  0.25,
+		mTraumaDecay: 1,
+		mMaxShake:    16,
+	}
+}
+
+//SetDeadZone sets the size of the area the target moves freely in
+func (c *Camera2D) SetDeadZone(width, height float64) {
+	c.mDeadZoneWidth = width
+	c.mDeadZoneHeight = height
+}
+
+//SetSmoothTime sets the catch up time in seconds
+func (c *Camera2D) SetSmoothTime(seconds float64) {
+	c.mSmoothTime = math.Max(seconds, 0)
+}
+
+//SetBounds sets the area the view is kept in
+func (c *Camera2D) SetBounds(bounds sdl.Rect) {
+	c.mBounds = bounds
+	c.clamp()
+}
+
+//SetZoom sets the world to screen scale
+func (c *Camera2D) SetZoom(zoom float64) {
+	c.mZoom = math.Min(math.Max(zoom, cameraMinZoom), cameraMaxZoom)
+	c.clamp()
+}
+
+//Zoom gets the world to screen scale
+func (c *Camera2D) Zoom() float64 {
+	return c.mZoom
+}
+
+//AddTrauma makes the camera shake, stronger the more trauma it has
+func (c *Camera2D) AddTrauma(amount float64) {
+	c.mTrauma = math.Min(math.Max(c.mTrauma+amount, 0), 1)
+}
+
+//Trauma gets the current shake strength
+func (c *Camera2D) Trauma() float64 {
+	return c.mTrauma
+}
+
+//SnapTo centers the view on a point right away
+func (c *Camera2D) SnapTo(x, y float64) {
+	c.mX = x
+	c.mY = y
+	c.mVelX = 0
+	c.mVelY = 0
+	c.clamp()
+}
+
+//Position gets the center of the view in the world
+func (c *Camera2D) Position() (float64, float64) {
+	return c.mX, c.mY
+}
+
+//Follow moves the view towards a target after the given number of seconds
+func (c *Camera2D) Follow(targetX, targetY, seconds float64) {
+	//Only chase the target once it leaves the dead zone
+	goalX := deadZoneGoal(c.mX, targetX, c.mDeadZoneWidth/c.mZoom)
+	goalY := deadZoneGoal(c.mY, targetY, c.mDeadZoneHeight/c.mZoom)
+
+	//Ease towards the goal
+	c.mX, c.mVelX = smoothDamp(c.mX, goalX, c.mVelX, c.mSmoothTime, seconds)
+	c.mY, c.mVelY = smoothDamp(c.mY, goalY, c.mVelY, c.mSmoothTime, seconds)
+	c.clamp()
+
+	//Shake falls off with the square of the trauma so small hits stay subtle
+	c.mTrauma = math.Max(c.mTrauma-c.mTraumaDecay*seconds, 0)
+	shake := c.mTrauma * c.mTrauma * c.mMaxShake
+	c.mShakeX = shake * (rand.Float64()*2 - 1)
+	c.mShakeY = shake * (rand.Float64()*2 - 1)
+}
+
+//Apply sets the renderer scale for drawing the world
+func (c *Camera2D) Apply(renderer *sdl.Renderer) error {
+	if err := renderer.SetScale(float32(c.mZoom), float32(c.mZoom)); err != nil {
+		return fmt.Errorf("could not set camera zoom: %v", err)
+	}
+
+	return nil
+}
+
+//View gets the part of the world on screen, shake included
+//Subtracting its position from world coordinates gives render coordinates once Apply was called
+func (c *Camera2D) View() sdl.Rect {
+	x, y := c.origin()
+
+	return sdl.Rect{
+		X: int32(math.Floor(x)),
+		Y: int32(math.Floor(y)),
+		W: int32(math.Ceil(float64(c.mViewWidth)/c.mZoom)) + 1,
+		H: int32(math.Ceil(float64(c.mViewHeight)/c.mZoom)) + 1,
+	}
+}
+
+//WorldToScreen converts a world point to screen pixels
+func (c *Camera2D) WorldToScreen(x, y float64) (float64, float64) {
+	view := c.View()
+
+	return (x - float64(view.X)) * c.mZoom, (y - float64(view.Y)) * c.mZoom
+}
+
+//ScreenToWorld converts screen pixels to a world point
+func (c *Camera2D) ScreenToWorld(x, y float64) (float64, float64) {
+	view := c.View()
+
+	return x/c.mZoom + float64(view.X), y/c.mZoom + float64(view.Y)
+}
+
+//origin gets the top left of the view in the world
+func (c *Camera2D) origin() (float64, float64) {
+	return c.mX - float64(c.mViewWidth)/(2*c.mZoom) + c.mShakeX/c.mZoom,
+		c.mY - float64(c.mViewHeight)/(2*c.mZoom) + c.mShakeY/c.mZoom
+}
+
+//clamp keeps the view inside the bounds
+func (c *Camera2D) clamp() {
+	if c.mBounds.Empty() {
+		return
+	}
+
+	c.mX = clampAxis(c.mX, float64(c.mViewWidth)/c.mZoom, float64(c.mBounds.X), float64(c.mBounds.W))
+	c.mY = clampAxis(c.mY, float64(c.mViewHeight)/c.mZoom, float64(c.mBounds.Y), float64(c.mBounds.H))
+}
+
+//clampAxis keeps a view center inside bounds, centering views bigger than the bounds
+func clampAxis(center, viewSize, low, size float64) float64 {
+	if viewSize >= size {
+		return low + size/2
+	}
+
+	return math.Min(math.Max(center, low+viewSize/2), low+size-viewSize/2)
+}
+
+//deadZoneGoal gets where the camera has to be for the target to be back in the dead zone
+func deadZoneGoal(center, target, deadZone float64) float64 {
+	if target > center+deadZone/2 {
+		return target - deadZone/2
+	}
+	if target < center-deadZone/2 {
+		return target + deadZone/2
+	}
+
+	return center
+}
+
+//smoothDamp moves towards a goal with a critically damped spring
+func smoothDamp(current, goal, velocity, smoothTime, seconds float64) (float64, float64) {
+	//Snap without smoothing
+	if smoothTime <= 0 {
+		return goal, 0
+	}
+
+	//Approximation of the exponential decay of the spring
+	omega := 2 / smoothTime
+	x := omega * seconds
+	decay := 1 / (1 + x + 0.48*x*x + 0.235*x*x*x)
+
+	change := current - goal
+	temp := (velocity + omega*change) * seconds
+	velocity = (velocity - omega*temp) * decay
+
+	return goal + (change+temp)*decay, velocity
+}
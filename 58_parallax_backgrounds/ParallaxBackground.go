@@ -0,0 +1,221 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//ParallaxLayer is one image of the background
+type ParallaxLayer struct {
+	//Layer image
+	mTexture LTexture
+	mPath    string
+
+	//How far the layer moves for each pixel the camera moves
+	//0 stays put on screen, 1 moves with the level
+	mFactorX float64
+	mFactorY float64
+
+	//Repeat the image along each axis
+	mTileX bool
+	mTileY bool
+
+	//Automatic scrolling in pixels per second
+	mVelX float64
+	mVelY float64
+
+	//Position of the layer with the camera at the level origin
+	mPosX float64
+	mPosY float64
+
+	//Distance scrolled on its own so far
+	mScrollX float64
+	mScrollY float64
+}
+
+//ParallaxBackground draws layers back to front, each scrolling at its own speed
+type ParallaxBackground struct {
+	mLayers []*ParallaxLayer
+}
+
+//NewParallaxBackground initializes an empty background
+func NewParallaxBackground() *ParallaxBackground {
+	return &ParallaxBackground{}
+}
+
+//LoadFromFile replaces the layers with the ones in a layer file
+//Each line is "image factorX factorY tiling velocityX velocityY x y", tiling is none, x, y or xy
+func (pb *ParallaxBackground) LoadFromFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("could not open layer file: %v", err)
+	}
+	defer file.Close()
+
+	//Parse everything before touching the current layers
+	var layers []*ParallaxLayer
+	scanner := bufio.NewScanner(file)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+
+		//Skip blank lines and comments
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) != 8 {
+			return fmt.Errorf("error loading layers: expected 8 fields at line %d", line)
+		}
+
+		layer, err := parseLayer(fields)
+		if err != nil {
+			return fmt.Errorf("error loading layers at line %d: %v", line, err)
+		}
+		layers = append(layers, layer)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("could not read layer file: %v", err)
+	}
+
+	//Load the images
+	for i, layer := range layers {
+		if err := layer.mTexture.LoadFromFile(layer.mPath); err != nil {
+			for _, loaded := range layers[:i] {
+				loaded.mTexture.Free()
+			}
+			return fmt.Errorf("could not load layer image: %v", err)
+		}
+	}
+
+	if err := pb.Free(); err != nil {
+		return err
+	}
+	pb.mLayers = layers
+
+	return nil
+}
+
+//Count gets the number of layers
+func (pb *ParallaxBackground) Count() int {
+	return len(pb.mLayers)
+}
+
+//Update scrolls the automatically moving layers after the given number of seconds
+func (pb *ParallaxBackground) Update(seconds float64) {
+	for _, layer := range pb.mLayers {
+		layer.mScrollX += layer.mVelX * seconds
+		layer.mScrollY += layer.mVelY * seconds
+
+		//Keep tiled offsets small so they don't lose precision over time
+		if layer.mTileX {
+			layer.mScrollX = math.Mod(layer.mScrollX, float64(layer.mTexture.GetWidth()))
+		}
+		if layer.mTileY {
+			layer.mScrollY = math.Mod(layer.mScrollY, float64(layer.mTexture.GetHeight()))
+		}
+	}
+}
+
+//Render draws the layers for a camera at the given level position
+func (pb *ParallaxBackground) Render(camX, camY float64, screenWidth, screenHeight int32) error {
+	for _, layer := range pb.mLayers {
+		if err := layer.render(camX, camY, float64(screenWidth), float64(screenHeight)); err != nil {
+			return fmt.Errorf("could not render layer %v: %v", layer.mPath, err)
+		}
+	}
+
+	return nil
+}
+
+//Free deallocates the layer images
+func (pb *ParallaxBackground) Free() error {
+	for _, layer := range pb.mLayers {
+		if err := layer.mTexture.Free(); err != nil {
+			return err
+		}
+	}
+	pb.mLayers = nil
+
+	return nil
+}
+
+//render draws the layer, repeating it to cover the screen along tiled axes
+func (pl *ParallaxLayer) render(camX, camY, screenWidth, screenHeight float64) error {
+	width := float64(pl.mTexture.GetWidth())
+	height := float64(pl.mTexture.GetHeight())
+
+	//Screen position of the layer, kept as floats so slow layers move smoothly
+	x := pl.mPosX + pl.mScrollX - camX*pl.mFactorX
+	y := pl.mPosY + pl.mScrollY - camY*pl.mFactorY
+
+	//Start tiling from the copy right before the screen edge
+	startX, endX := x, x+width
+	if pl.mTileX {
+		startX = math.Mod(x, width)
+		if startX > 0 {
+			startX -= width
+		}
+		endX = screenWidth
+	}
+	startY, endY := y, y+height
+	if pl.mTileY {
+		startY = math.Mod(y, height)
+		if startY > 0 {
+			startY -= height
+		}
+		endY = screenHeight
+	}
+
+	for tileY := startY; tileY < endY; tileY += height {
+		for tileX := startX; tileX < endX; tileX += width {
+			renderQuad := sdl.FRect{X: float32(tileX), Y: float32(tileY), W: float32(width), H: float32(height)}
+			if err := gRenderer.CopyF(pl.mTexture.mTexture, nil, &renderQuad); err != nil {
+				return fmt.Errorf("could not copy texture: %v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+//parseLayer builds a layer from its layer file fields
+func parseLayer(fields []string) (*ParallaxLayer, error) {
+	layer := &ParallaxLayer{mPath: fields[0]}
+
+	//Read the numbers
+	var numbers [6]float64
+	for i, field := range []string{fields[1], fields[2], fields[4], fields[5], fields[6], fields[7]} {
+		var err error
+		if numbers[i], err = strconv.ParseFloat(field, 64); err != nil {
+			return nil, fmt.Errorf("invalid number %q", field)
+		}
+	}
+	layer.mFactorX, layer.mFactorY = numbers[0], numbers[1]
+	layer.mVelX, layer.mVelY = numbers[2], numbers[3]
+	layer.mPosX, layer.mPosY = numbers[4], numbers[5]
+
+	//Read the tiling
+	switch fields[3] {
+	case "none":
+		break
+	case "x":
+		layer.mTileX = true
+		break
+	case "y":
+		layer.mTileY = true
+		break
+	case "xy":
+		layer.mTileX = true
+		layer.mTileY = true
+		break
+	default:
+		return nil, fmt.Errorf("unknown tiling %q", fields[3])
+	}
+
+	return layer, nil
+}
@@ -0,0 +1,326 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	//Fixed point precision of blur kernel weights
+	kernelShift = 16
+
+	//Widest gaussian blur, its kernel is six times as wide
+	maxBlurSigma = 64
+)
+
+//Filter is a post-processing step
+type Filter interface {
+	//Name gets the name shown to the user
+	Name() string
+
+	//Apply writes the filtered source into the destination
+	//Both images are the same size and never the same buffer
+	Apply(src, dst *PixelBuffer)
+}
+
+//GrayscaleFilter removes color using perceived brightness
+type GrayscaleFilter struct{}
+
+//Name gets the filter name
+func (f *GrayscaleFilter) Name() string {
+	return "grayscale"
+}
+
+//Apply turns every pixel gray
+func (f *GrayscaleFilter) Apply(src, dst *PixelBuffer) {
+	for i := 0; i < len(src.mPixels); i += 4 {
+		gray := luma(src.mPixels[i], src.mPixels[i+1], src.mPixels[i+2])
+		dst.mPixels[i], dst.mPixels[i+1], dst.mPixels[i+2], dst.mPixels[i+3] = gray, gray, gray, src.mPixels[i+3]
+	}
+}
+
+//SepiaFilter tints the image like an old photograph
+type SepiaFilter struct{}
+
+//Name gets the filter name
+func (f *SepiaFilter) Name() string {
+	return "sepia"
+}
+
+//Apply tints every pixel
+func (f *SepiaFilter) Apply(src, dst *PixelBuffer) {
+	for i := 0; i < len(src.mPixels); i += 4 {
+		r, g, b := float64(src.mPixels[i]), float64(src.mPixels[i+1]), float64(src.mPixels[i+2])
+		dst.mPixels[i] = clampByte(0.393*r + 0.769*g + 0.189*b)
+		dst.mPixels[i+1] = clampByte(0.349*r + 0.686*g + 0.168*b)
+		dst.mPixels[i+2] = clampByte(0.272*r + 0.534*g + 0.131*b)
+		dst.mPixels[i+3] = src.mPixels[i+3]
+	}
+}
+
+//BlurFilter averages every pixel with its neighbours in two separate passes
+type BlurFilter struct {
+	mName string
+
+	//Fixed point weights from the left or top neighbour to the right or bottom one
+	mKernel []int
+
+	//Result of the horizontal pass
+	mTemp *PixelBuffer
+}
+
+//NewBoxBlurFilter creates a blur weighting every neighbour within the radius the same
+func NewBoxBlurFilter(radius int) (*BlurFilter, error) {
+	if radius < 0 {
+		return nil, fmt.Errorf("invalid box blur radius %d", radius)
+	}
+
+	weights := make([]float64, radius*2+1)
+	for i := range weights {
+		weights[i] = 1
+	}
+
+	return &BlurFilter{mName: fmt.Sprintf("box blur %d", radius), mKernel: fixedKernel(weights)}, nil
+}
+
+//NewGaussianBlurFilter creates a blur with weights falling off along a bell curve
+func NewGaussianBlurFilter(sigma float64) (*BlurFilter, error) {
+	//A bell curve needs some width, and too wide a kernel would never finish
+	if !(sigma > 0 && sigma <= maxBlurSigma) {
+		return nil, fmt.Errorf("invalid gaussian blur sigma %v", sigma)
+	}
+
+	//Three deviations cover nearly all of the curve
+	radius := int(math.Ceil(sigma * 3))
+	weights := make([]float64, radius*2+1)
+	for i := range weights {
+		d := float64(i - radius)
+		weights[i] = math.Exp(-d * d / (2 * sigma * sigma))
+	}
+
+	return &BlurFilter{mName: fmt.Sprintf("gaussian blur %.1f", sigma), mKernel: fixedKernel(weights)}, nil
+}
+
+//Name gets the filter name
+func (f *BlurFilter) Name() string {
+	return f.mName
+}
+
+//Apply blurs horizontally into a scratch image and then vertically into the destination
+func (f *BlurFilter) Apply(src, dst *PixelBuffer) {
+	if f.mTemp == nil || f.mTemp.mWidth != src.mWidth || f.mTemp.mHeight != src.mHeight {
+		f.mTemp = NewPixelBuffer(src.mWidth, src.mHeight)
+	}
+
+	convolve(src, f.mTemp, f.mKernel, 1, 0)
+	convolve(f.mTemp, dst, f.mKernel, 0, 1)
+}
+
+//BloomFilter makes bright areas glow
+type BloomFilter struct {
+	//Brightness a pixel needs to glow
+	mThreshold uint8
+
+	//How strongly the glow is added back
+	mIntensity float64
+
+	//Spreads the glow
+	mBlur *BlurFilter
+
+	//Bright pixels and their blurred glow
+	mBright *PixelBuffer
+	mGlow   *PixelBuffer
+}
+
+//NewBloomFilter creates a bloom with the given brightness threshold, glow strength and spread
+func NewBloomFilter(threshold uint8, intensity, sigma float64) (*BloomFilter, error) {
+	blur, err := NewGaussianBlurFilter(sigma)
+	if err != nil {
+		return nil, fmt.Errorf("could not create bloom blur: %v", err)
+	}
+
+	return &BloomFilter{mThreshold: threshold, mIntensity: intensity, mBlur: blur}, nil
+}
+
+//Name gets the filter name
+func (f *BloomFilter) Name() string {
+	return fmt.Sprintf("bloom %d", f.mThreshold)
+}
+
+//Apply keeps the pixels over the threshold, blurs them and adds them on top of the source
+func (f *BloomFilter) Apply(src, dst *PixelBuffer) {
+	if f.mBright == nil || f.mBright.mWidth != src.mWidth || f.mBright.mHeight != src.mHeight {
+		f.mBright = NewPixelBuffer(src.mWidth, src.mHeight)
+		f.mGlow = NewPixelBuffer(src.mWidth, src.mHeight)
+	}
+
+	//Bright pass
+	for i := 0; i < len(src.mPixels); i += 4 {
+		if luma(src.mPixels[i], src.mPixels[i+1], src.mPixels[i+2]) >= f.mThreshold {
+			copy(f.mBright.mPixels[i:i+4], src.mPixels[i:i+4])
+		} else {
+			f.mBright.mPixels[i], f.mBright.mPixels[i+1], f.mBright.mPixels[i+2], f.mBright.mPixels[i+3] = 0, 0, 0, 0
+		}
+	}
+
+	//Spread the glow
+	f.mBlur.Apply(f.mBright, f.mGlow)
+
+	//Add it back
+	for i := 0; i < len(src.mPixels); i += 4 {
+		for c := 0; c < 3; c++ {
+			dst.mPixels[i+c] = clampByte(float64(src.mPixels[i+c]) + float64(f.mGlow.mPixels[i+c])*f.mIntensity)
+		}
+		dst.mPixels[i+3] = src.mPixels[i+3]
+	}
+}
+
+//ScanlineFilter imitates a CRT with dark scanlines and a vignette
+type ScanlineFilter struct {
+	//How much darker every other row is, from 0 to 1
+	mIntensity float64
+}
+
+//NewScanlineFilter creates a scanline filter
+func NewScanlineFilter(intensity float64) (*ScanlineFilter, error) {
+	if !(intensity >= 0 && intensity <= 1) {
+		return nil, fmt.Errorf("invalid scanline intensity %v, must be from 0 to 1", intensity)
+	}
+
+	return &ScanlineFilter{mIntensity: intensity}, nil
+}
+
+//Name gets the filter name
+func (f *ScanlineFilter) Name() string {
+	return "scanlines"
+}
+
+//Apply darkens odd rows and the corners
+func (f *ScanlineFilter) Apply(src, dst *PixelBuffer) {
+	centerX := float64(src.mWidth-1) / 2
+	centerY := float64(src.mHeight-1) / 2
+
+	for y := 0; y < src.mHeight; y++ {
+		row := 1.0
+		if y%2 == 1 {
+			row -= f.mIntensity
+		}
+
+		for x := 0; x < src.mWidth; x++ {
+			//Distance from the center, 1 in the corners and 0 along a single row or column
+			var dx, dy float64
+			if centerX > 0 {
+				dx = (float64(x) - centerX) / centerX
+			}
+			if centerY > 0 {
+				dy = (float64(y) - centerY) / centerY
+			}
+			shade := row * (1 - 0.25*(dx*dx+dy*dy)/2)
+
+			i := (y*src.mWidth + x) * 4
+			for c := 0; c < 3; c++ {
+				dst.mPixels[i+c] = clampByte(float64(src.mPixels[i+c]) * shade)
+			}
+			dst.mPixels[i+3] = src.mPixels[i+3]
+		}
+	}
+}
+
+//PaletteFilter replaces every color with the closest one in a palette
+type PaletteFilter struct {
+	mName    string
+	mPalette []sdl.Color
+}
+
+//NewPaletteFilter creates a palette quantization filter
+func NewPaletteFilter(name string, palette []sdl.Color) (*PaletteFilter, error) {
+	if len(palette) == 0 {
+		return nil, fmt.Errorf("palette %q has no colors", name)
+	}
+
+	return &PaletteFilter{mName: name, mPalette: palette}, nil
+}
+
+//Name gets the filter name
+func (f *PaletteFilter) Name() string {
+	return f.mName
+}
+
+//Apply quantizes every pixel
+func (f *PaletteFilter) Apply(src, dst *PixelBuffer) {
+	for i := 0; i < len(src.mPixels); i += 4 {
+		r, g, b := int(src.mPixels[i]), int(src.mPixels[i+1]), int(src.mPixels[i+2])
+
+		//Find the closest palette color
+		best, bestDistance := 0, math.MaxInt32
+		for j, color := range f.mPalette {
+			dr, dg, db := r-int(color.R), g-int(color.G), b-int(color.B)
+			if distance := dr*dr + dg*dg + db*db; distance < bestDistance {
+				best, bestDistance = j, distance
+			}
+		}
+
+		color := f.mPalette[best]
+		dst.mPixels[i], dst.mPixels[i+1], dst.mPixels[i+2], dst.mPixels[i+3] = color.R, color.G, color.B, src.mPixels[i+3]
+	}
+}
+
+//convolve runs a one dimensional kernel over the image along a direction
+func convolve(src, dst *PixelBuffer, kernel []int, stepX, stepY int) {
+	radius := len(kernel) / 2
+
+	for y := 0; y < src.mHeight; y++ {
+		for x := 0; x < src.mWidth; x++ {
+			var sum [4]int
+			for k, weight := range kernel {
+				j := src.offset(x+(k-radius)*stepX, y+(k-radius)*stepY)
+				for c := 0; c < 4; c++ {
+					sum[c] += int(src.mPixels[j+c]) * weight
+				}
+			}
+
+			i := (y*src.mWidth + x) * 4
+			for c := 0; c < 4; c++ {
+				dst.mPixels[i+c] = uint8((sum[c] + 1<<(kernelShift-1)) >> kernelShift)
+			}
+		}
+	}
+}
+
+//fixedKernel normalizes weights to fixed point values that add up to one
+func fixedKernel(weights []float64) []int {
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+
+	//Give the rounding error to the center weight so flat areas stay the same
+	kernel := make([]int, len(weights))
+	sum := 0
+	for i, w := range weights {
+		kernel[i] = int(math.Round(w / total * (1 << kernelShift)))
+		sum += kernel[i]
+	}
+	kernel[len(kernel)/2] += 1<<kernelShift - sum
+
+	return kernel
+}
+
+//luma gets the perceived brightness of a color
+func luma(r, g, b uint8) uint8 {
+	return uint8((299*int(r) + 587*int(g) + 114*int(b)) / 1000)
+}
+
+//clampByte rounds a channel value into the 0 to 255 range
+func clampByte(value float64) uint8 {
+	if value <= 0 {
+		return 0
+	}
+	if value >= 255 {
+		return 255
+	}
+
+	return uint8(value + 0.5)
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//newTestBuffer creates an image from rows of R, G, B, A pixels
+func newTestBuffer(rows [][][4]uint8) *PixelBuffer {
+	pb := NewPixelBuffer(len(rows[0]), len(rows))
+	for y, row := range rows {
+		for x, p := range row {
+			pb.Set(x, y, p[0], p[1], p[2], p[3])
+		}
+	}
+
+	return pb
+}
+
+//newFlatBuffer creates an image filled with one color
+func newFlatBuffer(width, height int, r, g, b, a uint8) *PixelBuffer {
+	pb := NewPixelBuffer(width, height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			pb.Set(x, y, r, g, b, a)
+		}
+	}
+
+	return pb
+}
+
+//checkPixel fails the test if a pixel isn't the expected color
+func checkPixel(t *testing.T, pb *PixelBuffer, x, y int, want [4]uint8) {
+	t.Helper()
+
+	r, g, b, a := pb.At(x, y)
+	if got := [4]uint8{r, g, b, a}; got != want {
+		t.Errorf("pixel (%d, %d) = %v, want %v", x, y, got, want)
+	}
+}
+
+func TestGrayscaleFilter(t *testing.T) {
+	src := newTestBuffer([][][4]uint8{{
+		{200, 100, 50, 128},
+		{255, 0, 0, 255},
+		{0, 255, 0, 255},
+		{0, 0, 255, 0},
+	}})
+	dst := NewPixelBuffer(src.Width(), src.Height())
+
+	(&GrayscaleFilter{}).Apply(src, dst)
+
+	//Luma weights are 0.299, 0.587 and 0.114, alpha is kept
+	checkPixel(t, dst, 0, 0, [4]uint8{124, 124, 124, 128})
+	checkPixel(t, dst, 1, 0, [4]uint8{76, 76, 76, 255})
+	checkPixel(t, dst, 2, 0, [4]uint8{149, 149, 149, 255})
+	checkPixel(t, dst, 3, 0, [4]uint8{29, 29, 29, 0})
+}
+
+func TestSepiaFilter(t *testing.T) {
+	src := newTestBuffer([][][4]uint8{{
+		{100, 50, 20, 200},
+		{255, 255, 255, 255},
+		{0, 0, 0, 255},
+	}})
+	dst := NewPixelBuffer(src.Width(), src.Height())
+
+	(&SepiaFilter{}).Apply(src, dst)
+
+	//Each channel is a weighted sum of the source channels, rounded and clamped
+	checkPixel(t, dst, 0, 0, [4]uint8{82, 73, 57, 200})
+	checkPixel(t, dst, 1, 0, [4]uint8{255, 255, 239, 255})
+	checkPixel(t, dst, 2, 0, [4]uint8{0, 0, 0, 255})
+}
+
+func TestFixedKernel(t *testing.T) {
+	var kernels [][]int
+	for radius := 0; radius <= 6; radius++ {
+		filter, err := NewBoxBlurFilter(radius)
+		if err != nil {
+			t.Fatal(err)
+		}
+		kernels = append(kernels, filter.mKernel)
+	}
+	for _, sigma := range []float64{0.5, 1, 1.5, 2.5, 4} {
+		filter, err := NewGaussianBlurFilter(sigma)
+		if err != nil {
+			t.Fatal(err)
+		}
+		kernels = append(kernels, filter.mKernel)
+	}
+
+	for _, kernel := range kernels {
+		//Weights add up to exactly one in fixed point
+		sum := 0
+		for _, weight := range kernel {
+			sum += weight
+		}
+		if sum != 1<<kernelShift {
+			t.Errorf("kernel %v adds up to %d, want %d", kernel, sum, 1<<kernelShift)
+		}
+
+		//Both sides weigh the same
+		for i := range kernel {
+			if kernel[i] != kernel[len(kernel)-1-i] {
+				t.Errorf("kernel %v isn't symmetric", kernel)
+				break
+			}
+		}
+	}
+}
+
+func TestBlurFilter(t *testing.T) {
+	//A flat image stays the same, edges included
+	src := newFlatBuffer(7, 5, 200, 100, 50, 255)
+	boxBlur, err := NewBoxBlurFilter(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gaussianBlur, err := NewGaussianBlurFilter(1.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, filter := range []*BlurFilter{boxBlur, gaussianBlur} {
+		dst := NewPixelBuffer(src.Width(), src.Height())
+		filter.Apply(src, dst)
+
+		for y := 0; y < src.Height(); y++ {
+			for x := 0; x < src.Width(); x++ {
+				checkPixel(t, dst, x, y, [4]uint8{200, 100, 50, 255})
+			}
+		}
+	}
+
+	//A box blur spreads a single pixel evenly over its neighbours
+	src = NewPixelBuffer(5, 5)
+	src.Set(2, 2, 90, 90, 90, 90)
+	dst := NewPixelBuffer(5, 5)
+	boxBlur, err = NewBoxBlurFilter(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	boxBlur.Apply(src, dst)
+
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			want := [4]uint8{}
+			if x >= 1 && x <= 3 && y >= 1 && y <= 3 {
+				want = [4]uint8{10, 10, 10, 10}
+			}
+			checkPixel(t, dst, x, y, want)
+		}
+	}
+}
+
+func TestBloomFilter(t *testing.T) {
+	//Only the white pixel is over the threshold
+	src := newFlatBuffer(5, 5, 100, 100, 100, 255)
+	src.Set(2, 2, 255, 255, 255, 255)
+	dst := NewPixelBuffer(5, 5)
+
+	filter, err := NewBloomFilter(200, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filter.Apply(src, dst)
+
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			want := [4]uint8{}
+			if x == 2 && y == 2 {
+				want = [4]uint8{255, 255, 255, 255}
+			}
+			checkPixel(t, filter.mBright, x, y, want)
+		}
+	}
+
+	//The glow brightens the neighbours but never darkens anything
+	checkPixel(t, dst, 2, 2, [4]uint8{255, 255, 255, 255})
+	if r, _, _, _ := dst.At(1, 2); r <= 100 {
+		t.Errorf("neighbour of bright pixel = %d, want brighter than 100", r)
+	}
+
+	//Nothing glows under the threshold
+	src = newFlatBuffer(5, 5, 100, 100, 100, 255)
+	filter.Apply(src, dst)
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			checkPixel(t, dst, x, y, [4]uint8{100, 100, 100, 255})
+		}
+	}
+}
+
+func TestScanlineFilter(t *testing.T) {
+	src := newFlatBuffer(3, 3, 200, 200, 200, 255)
+	dst := NewPixelBuffer(3, 3)
+
+	filter, err := NewScanlineFilter(0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filter.Apply(src, dst)
+
+	//Even rows only have the vignette, from none in the center to a quarter in the corners
+	checkPixel(t, dst, 0, 0, [4]uint8{150, 150, 150, 255})
+	checkPixel(t, dst, 1, 0, [4]uint8{175, 175, 175, 255})
+	checkPixel(t, dst, 2, 2, [4]uint8{150, 150, 150, 255})
+	checkPixel(t, dst, 1, 2, [4]uint8{175, 175, 175, 255})
+
+	//The odd row is half as bright on top of that
+	checkPixel(t, dst, 0, 1, [4]uint8{88, 88, 88, 255})
+	checkPixel(t, dst, 1, 1, [4]uint8{100, 100, 100, 255})
+}
+
+func TestPaletteFilter(t *testing.T) {
+	filter, err := NewPaletteFilter("test", []sdl.Color{
+		{R: 0, G: 0, B: 0, A: 255},
+		{R: 255, G: 0, B: 0, A: 255},
+		{R: 255, G: 255, B: 255, A: 255},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := newTestBuffer([][][4]uint8{{
+		{200, 40, 30, 255},
+		{90, 90, 90, 128},
+		{180, 170, 160, 255},
+		{255, 0, 0, 0},
+	}})
+	dst := NewPixelBuffer(src.Width(), src.Height())
+
+	filter.Apply(src, dst)
+
+	//Every pixel becomes the nearest palette color, alpha is kept
+	checkPixel(t, dst, 0, 0, [4]uint8{255, 0, 0, 255})
+	checkPixel(t, dst, 1, 0, [4]uint8{0, 0, 0, 128})
+	checkPixel(t, dst, 2, 0, [4]uint8{255, 255, 255, 255})
+	checkPixel(t, dst, 3, 0, [4]uint8{255, 0, 0, 0})
+}
+
+func TestScanlineFilterThinImages(t *testing.T) {
+	filter, err := NewScanlineFilter(0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//A single row or column has no vignette across it
+	tests := []struct {
+		mWidth  int
+		mHeight int
+		mX      int
+		mY      int
+		mWant   uint8
+	}{
+		{1, 1, 0, 0, 200},
+		{1, 3, 0, 0, 175},
+		{1, 3, 0, 1, 100},
+		{3, 1, 0, 0, 175},
+		{3, 1, 1, 0, 200},
+	}
+
+	for _, test := range tests {
+		src := newFlatBuffer(test.mWidth, test.mHeight, 200, 200, 200, 255)
+		dst := NewPixelBuffer(test.mWidth, test.mHeight)
+		filter.Apply(src, dst)
+
+		checkPixel(t, dst, test.mX, test.mY, [4]uint8{test.mWant, test.mWant, test.mWant, 255})
+	}
+}
+
+func TestFilterSettings(t *testing.T) {
+	tests := []struct {
+		mName   string
+		mCreate func() error
+		mValid  bool
+	}{
+		{"box blur 0", func() error { _, err := NewBoxBlurFilter(0); return err }, true},
+		{"box blur -1", func() error { _, err := NewBoxBlurFilter(-1); return err }, false},
+		{"gaussian blur 0", func() error { _, err := NewGaussianBlurFilter(0); return err }, false},
+		{"gaussian blur -1", func() error { _, err := NewGaussianBlurFilter(-1); return err }, false},
+		{"gaussian blur NaN", func() error { _, err := NewGaussianBlurFilter(math.NaN()); return err }, false},
+		{"gaussian blur too wide", func() error { _, err := NewGaussianBlurFilter(maxBlurSigma + 1); return err }, false},
+		{"bloom sigma 0", func() error { _, err := NewBloomFilter(200, 1, 0); return err }, false},
+		{"scanlines 0", func() error { _, err := NewScanlineFilter(0); return err }, true},
+		{"scanlines 1", func() error { _, err := NewScanlineFilter(1); return err }, true},
+		{"scanlines 1.5", func() error { _, err := NewScanlineFilter(1.5); return err }, false},
+		{"scanlines -0.5", func() error { _, err := NewScanlineFilter(-0.5); return err }, false},
+		{"one color palette", func() error { _, err := NewPaletteFilter("one", []sdl.Color{{A: 255}}); return err }, true},
+		{"empty palette", func() error { _, err := NewPaletteFilter("empty", nil); return err }, false},
+	}
+
+	for _, test := range tests {
+		if err := test.mCreate(); (err == nil) != test.mValid {
+			t.Errorf("%v: error %v, want valid %v", test.mName, err, test.mValid)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+//PixelBuffer is an image in memory with 4 bytes per pixel in R, G, B, A order
+type PixelBuffer struct {
+	mPixels []byte
+	mWidth  int
+	mHeight int
+}
+
+//NewPixelBuffer allocates a transparent black image
+func NewPixelBuffer(width, height int) *PixelBuffer {
+	return &PixelBuffer{mPixels: make([]byte, width*height*4), mWidth: width, mHeight: height}
+}
+
+//Width gets the image width
+func (pb *PixelBuffer) Width() int {
+	return pb.mWidth
+}
+
+//Height gets the image height
+func (pb *PixelBuffer) Height() int {
+	return pb.mHeight
+}
+
+//Pitch gets the length of a row in bytes
+func (pb *PixelBuffer) Pitch() int {
+	return pb.mWidth * 4
+}
+
+//Pixels gets the raw pixel bytes
+func (pb *PixelBuffer) Pixels() []byte {
+	return pb.mPixels
+}
+
+//At gets a pixel, clamping coordinates to the image edges
+func (pb *PixelBuffer) At(x, y int) (r, g, b, a uint8) {
+	i := pb.offset(x, y)
+	return pb.mPixels[i], pb.mPixels[i+1], pb.mPixels[i+2], pb.mPixels[i+3]
+}
+
+//Set sets a pixel, ignoring coordinates outside the image
+func (pb *PixelBuffer) Set(x, y int, r, g, b, a uint8) {
+	if x < 0 || y < 0 || x >= pb.mWidth || y >= pb.mHeight {
+		return
+	}
+
+	i := (y*pb.mWidth + x) * 4
+	pb.mPixels[i], pb.mPixels[i+1], pb.mPixels[i+2], pb.mPixels[i+3] = r, g, b, a
+}
+
+//CopyFrom copies the pixels of an image the same size
+func (pb *PixelBuffer) CopyFrom(src *PixelBuffer) {
+	copy(pb.mPixels, src.mPixels)
+}
+
+//offset gets the index of a pixel's first byte, clamping coordinates to the image edges
+func (pb *PixelBuffer) offset(x, y int) int {
+	if x < 0 {
+		x = 0
+	} else if x >= pb.mWidth {
+		x = pb.mWidth - 1
+	}
+	if y < 0 {
+		y = 0
+	} else if y >= pb.mHeight {
+		y = pb.mHeight - 1
+	}
+
+	return (y*pb.mWidth + x) * 4
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//PostProcessor renders the scene to a texture and runs it through a chain of filters
+type PostProcessor struct {
+	//Texture the scene is rendered to
+	mTarget LTexture
+
+	//Texture the filtered pixels are uploaded to
+	mOutput LTexture
+
+	//Pixels read back from the target and ping-ponged between filters
+	mFront *PixelBuffer
+	mBack  *PixelBuffer
+
+	//Filters applied in order
+	mFilters []Filter
+}
+
+//NewPostProcessor initializes an empty filter chain
+func NewPostProcessor() *PostProcessor {
+	return &PostProcessor{}
+}
+
+//Init creates the textures and buffers for the given size
+func (pp *PostProcessor) Init(width, height int32) error {
+	if err := pp.mTarget.CreateBlank(width, height, sdl.TEXTUREACCESS_TARGET); err != nil {
+		return fmt.Errorf("could not create post-processing target: %v", err)
+	}
+	if err := pp.mOutput.CreateBlank(width, height, sdl.TEXTUREACCESS_STREAMING); err != nil {
+		return fmt.Errorf("could not create post-processing output: %v", err)
+	}
+
+	pp.mFront = NewPixelBuffer(int(width), int(height))
+	pp.mBack = NewPixelBuffer(int(width), int(height))
+
+	return nil
+}
+
+//SetFilters replaces the filter chain
+func (pp *PostProcessor) SetFilters(filters ...Filter) {
+	pp.mFilters = filters
+}
+
+//Filters gets the filter chain
+func (pp *PostProcessor) Filters() []Filter {
+	return pp.mFilters
+}
+
+//Begin makes the scene render to the target texture
+func (pp *PostProcessor) Begin() error {
+	return pp.mTarget.SetAsRenderTarget()
+}
+
+//End reads the scene back, runs the filters and uploads the result
+func (pp *PostProcessor) End() error {
+	//Nothing to do without filters
+	if len(pp.mFilters) == 0 {
+		if err := gRenderer.SetRenderTarget(nil); err != nil {
+			return fmt.Errorf("could not reset render target: %v", err)
+		}
+		return nil
+	}
+
+	//Read the scene while the target is still bound
+	err := gRenderer.ReadPixels(nil, uint32(sdl.PIXELFORMAT_RGBA32), unsafe.Pointer(&pp.mFront.mPixels[0]), pp.mFront.Pitch())
+	if err != nil {
+		return fmt.Errorf("could not read back scene: %v", err)
+	}
+	if err := gRenderer.SetRenderTarget(nil); err != nil {
+		return fmt.Errorf("could not reset render target: %v", err)
+	}
+
+	//Run the chain, the result always ends up in front
+	for _, filter := range pp.mFilters {
+		filter.Apply(pp.mFront, pp.mBack)
+		pp.mFront, pp.mBack = pp.mBack, pp.mFront
+	}
+
+	//Upload the result
+	if err := pp.mOutput.mTexture.Update(nil, unsafe.Pointer(&pp.mFront.mPixels[0]), pp.mFront.Pitch()); err != nil {
+		return fmt.Errorf("could not upload filtered scene: %v", err)
+	}
+
+	return nil
+}
+
+//Render shows the processed scene
+func (pp *PostProcessor) Render(x, y int32, angle float64, center *sdl.Point) error {
+	if len(pp.mFilters) == 0 {
+		return pp.mTarget.Render(x, y, nil, angle, center, sdl.FLIP_NONE)
+	}
+
+	return pp.mOutput.Render(x, y, nil, angle, center, sdl.FLIP_NONE)
+}
+
+//Free deallocates the textures
+func (pp *PostProcessor) Free() error {
+	if err := pp.mTarget.Free(); err != nil {
+		return fmt.Errorf("could not free post-processing target: %v", err)
+	}
+	if err := pp.mOutput.Free(); err != nil {
+		return fmt.Errorf("could not free post-processing output: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,425 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/color/palette"
+	"image/gif"
+	"image/png"
+	"os"
+	"path/filepath"
+	"time"
+	"unsafe"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//CaptureMode is what a recording is saved as
+type CaptureMode int
+
+//Recording formats
+const (
+	captureGIF CaptureMode = iota
+	capturePNGSequence
+)
+
+//String gets the name of the capture mode
+func (m CaptureMode) String() string {
+	switch m {
+	case captureGIF:
+		return "GIF"
+	case capturePNGSequence:
+		return "PNG sequence"
+	}
+
+	return "unknown"
+}
+
+const (
+	//Shortest GIF frame delay in milliseconds, viewers slow down anything faster
+	gifMinFrameDelay = 20
+
+	//Most frames a GIF recording keeps in memory, 10 seconds at the fastest GIF frame rate
+	gifMaxFrames = 500
+)
+
+//FrameCapture saves screenshots and recordings of what the renderer shows
+type FrameCapture struct {
+	//Renderer read back from
+	mRenderer *sdl.Renderer
+
+	//Directory captures are saved in
+	mDirectory string
+
+	//Recording state
+	mRecording bool
+	mMode      CaptureMode
+	mFrames    int
+
+	//Where the recording is saved, a GIF file or a PNG sequence directory
+	mPath string
+
+	//Quantized GIF frames and their delays in hundredths of a second
+	mGIF           *gif.GIF
+	mLastFrameTime uint32
+
+	//Milliseconds left over from rounding delays down, added to the next frame
+	mDelayRemainder uint32
+
+	//Fixed time between frames in milliseconds, 0 to use the clock
+	mFrameDelay uint32
+
+	//GIF recordings are saved once they have this many frames
+	mMaxGIFFrames int
+
+	//Maps frame colors to the GIF palette, built on the first recording
+	mQuantizer *gifQuantizer
+}
+
+//4x4 Bayer matrix thresholds for ordered dithering
+var bayer4 = [4][4]int{
+	{0, 8, 2, 10},
+	{12, 4, 14, 6},
+	{3, 11, 1, 9},
+	{15, 7, 13, 5},
+}
+
+//gifQuantizer reduces frames to a palette through a lookup table of 5 bits per channel
+//Searching the palette for every pixel is far too slow to keep up with recording
+type gifQuantizer struct {
+	mPalette color.Palette
+	mColors  []color.RGBA
+	mTable   [1 << 15]uint8
+}
+
+//NewFrameCapture initializes a capture service saving to the given directory
+func NewFrameCapture(renderer *sdl.Renderer, directory string) *FrameCapture {
+	return &FrameCapture{mRenderer: renderer, mDirectory: directory, mMaxGIFFrames: gifMaxFrames}
+}
+
+//SetFrameDelay records every frame as lasting the given milliseconds instead of timing them
+//Headless runs render faster than real time, so this keeps their recordings at normal speed
+func (fc *FrameCapture) SetFrameDelay(milliseconds uint32) {
+	fc.mFrameDelay = milliseconds
+}
+
+//HandleEvent takes F12 for screenshots, F11 to record a GIF and F10 to record a PNG sequence
+func (fc *FrameCapture) HandleEvent(e sdl.Event) error {
+	if e.GetType() != sdl.KEYDOWN || (e.(*sdl.KeyboardEvent)).Repeat != 0 {
+		return nil
+	}
+
+	switch (e.(*sdl.KeyboardEvent)).Keysym.Sym {
+	case sdl.K_F12:
+		path, err := fc.Screenshot()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Saved screenshot %v\n", path)
+		break
+
+	case sdl.K_F11:
+		return fc.toggleRecording(captureGIF)
+
+	case sdl.K_F10:
+		return fc.toggleRecording(capturePNGSequence)
+	}
+
+	return nil
+}
+
+//Grab reads back what has been rendered so far, call it before presenting
+func (fc *FrameCapture) Grab() (*image.RGBA, error) {
+	width, height, err := fc.mRenderer.GetOutputSize()
+	if err != nil {
+		return nil, fmt.Errorf("could not get renderer output size: %v", err)
+	}
+
+	//image.RGBA stores bytes in the same order as SDL's RGBA32
+	frame := image.NewRGBA(image.Rect(0, 0, int(width), int(height)))
+	err = fc.mRenderer.ReadPixels(nil, uint32(sdl.PIXELFORMAT_RGBA32), unsafe.Pointer(&frame.Pix[0]), frame.Stride)
+	if err != nil {
+		return nil, fmt.Errorf("could not read renderer pixels: %v", err)
+	}
+
+	return frame, nil
+}
+
+//Screenshot saves the current frame as a timestamped PNG
+func (fc *FrameCapture) Screenshot() (string, error) {
+	frame, err := fc.Grab()
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(fc.mDirectory, 0755); err != nil {
+		return "", fmt.Errorf("could not create capture directory: %v", err)
+	}
+
+	path := filepath.Join(fc.mDirectory, "screenshot-"+timestamp()+".png")
+	if err := savePNG(path, frame); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+//StartRecording begins capturing every frame
+func (fc *FrameCapture) StartRecording(mode CaptureMode) error {
+	if fc.mRecording {
+		return fmt.Errorf("already recording")
+	}
+
+	if err := os.MkdirAll(fc.mDirectory, 0755); err != nil {
+		return fmt.Errorf("could not create capture directory: %v", err)
+	}
+
+	switch mode {
+	case captureGIF:
+		fc.mPath = filepath.Join(fc.mDirectory, "recording-"+timestamp()+".gif")
+		fc.mGIF = &gif.GIF{}
+		if fc.mQuantizer == nil {
+			fc.mQuantizer = newGIFQuantizer(palette.Plan9)
+		}
+		break
+	case capturePNGSequence:
+		fc.mPath = filepath.Join(fc.mDirectory, "recording-"+timestamp())
+		if err := os.Mkdir(fc.mPath, 0755); err != nil {
+			return fmt.Errorf("could not create sequence directory: %v", err)
+		}
+		break
+	default:
+		return fmt.Errorf("unknown capture mode %d", mode)
+	}
+
+	fc.mRecording = true
+	fc.mMode = mode
+	fc.mFrames = 0
+	fc.mDelayRemainder = 0
+	fc.mLastFrameTime = sdl.GetTicks()
+	if fc.mFrameDelay > 0 {
+		fc.mLastFrameTime = 0
+	}
+
+	return nil
+}
+
+//CaptureFrame records the current frame if recording, call it before presenting
+func (fc *FrameCapture) CaptureFrame() error {
+	if !fc.mRecording {
+		return nil
+	}
+
+	//Drop frames that come too fast for a GIF, their time goes to the previous frame
+	now := sdl.GetTicks()
+	if fc.mFrameDelay > 0 {
+		now = fc.mLastFrameTime + fc.mFrameDelay
+	}
+	if fc.mMode == captureGIF && fc.mFrameDelay == 0 && fc.mFrames > 0 && now-fc.mLastFrameTime < gifMinFrameDelay {
+		return nil
+	}
+
+	frame, err := fc.Grab()
+	if err != nil {
+		return err
+	}
+
+	switch fc.mMode {
+	case captureGIF:
+		//Set how long the previous frame stays up, carrying what doesn't fit in hundredths so long recordings don't drift
+		if fc.mFrames > 0 {
+			elapsed := now - fc.mLastFrameTime + fc.mDelayRemainder
+			fc.mGIF.Delay[fc.mFrames-1] = int(elapsed / 10)
+			fc.mDelayRemainder = elapsed % 10
+		}
+
+		//GIFs hold at most 256 colors
+		fc.mGIF.Image = append(fc.mGIF.Image, fc.mQuantizer.Quantize(frame))
+		fc.mGIF.Delay = append(fc.mGIF.Delay, gifMinFrameDelay/10)
+		break
+
+	case capturePNGSequence:
+		path := filepath.Join(fc.mPath, fmt.Sprintf("frame-%05d.png", fc.mFrames))
+		if err := savePNG(path, frame); err != nil {
+			return err
+		}
+		break
+	}
+
+	fc.mFrames++
+	fc.mLastFrameTime = now
+
+	//Every GIF frame stays in memory until the end, so stop before it gets too big
+	if fc.mMode == captureGIF && fc.mFrames >= fc.mMaxGIFFrames {
+		path, err := fc.StopRecording()
+		if err != nil {
+			return err
+		}
+		fmt.Printf("GIF recording reached %d frames, saved %v\n", fc.mFrames, path)
+	}
+
+	return nil
+}
+
+//StopRecording finishes the recording and gets where it was saved
+func (fc *FrameCapture) StopRecording() (string, error) {
+	if !fc.mRecording {
+		return "", fmt.Errorf("not recording")
+	}
+	fc.mRecording = false
+
+	//Release the frames whatever happens
+	animation := fc.mGIF
+	fc.mGIF = nil
+
+	//Leave nothing behind for an empty recording
+	if fc.mFrames == 0 {
+		if fc.mMode == capturePNGSequence {
+			os.Remove(fc.mPath)
+		}
+		return "", fmt.Errorf("no frames were recorded")
+	}
+
+	if fc.mMode == captureGIF {
+		if err := saveGIF(fc.mPath, animation); err != nil {
+			return "", err
+		}
+	}
+
+	return fc.mPath, nil
+}
+
+//IsRecording gets whether frames are being recorded
+func (fc *FrameCapture) IsRecording() bool {
+	return fc.mRecording
+}
+
+//Mode gets the format of the current recording
+func (fc *FrameCapture) Mode() CaptureMode {
+	return fc.mMode
+}
+
+//Frames gets the number of frames in the current recording
+func (fc *FrameCapture) Frames() int {
+	return fc.mFrames
+}
+
+//toggleRecording starts or stops a recording
+func (fc *FrameCapture) toggleRecording(mode CaptureMode) error {
+	if !fc.mRecording {
+		return fc.StartRecording(mode)
+	}
+
+	path, err := fc.StopRecording()
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Saved %v recording %v\n", fc.mMode, path)
+
+	return nil
+}
+
+//newGIFQuantizer builds the lookup table for a palette of at most 256 colors
+func newGIFQuantizer(colors color.Palette) *gifQuantizer {
+	q := &gifQuantizer{mPalette: colors, mColors: make([]color.RGBA, len(colors))}
+	for i, c := range colors {
+		q.mColors[i] = color.RGBAModel.Convert(c).(color.RGBA)
+	}
+
+	for i := range q.mTable {
+		//Look up the middle of each 5 bit bucket
+		r := uint8(i>>10)<<3 | 4
+		g := uint8(i>>5&31)<<3 | 4
+		b := uint8(i&31)<<3 | 4
+		q.mTable[i] = uint8(colors.Index(color.RGBA{R: r, G: g, B: b, A: 255}))
+	}
+
+	return q
+}
+
+//Quantize converts a frame to the palette with ordered dithering to keep gradients smooth
+func (q *gifQuantizer) Quantize(frame *image.RGBA) *image.Paletted {
+	bounds := frame.Bounds()
+	paletted := image.NewPaletted(bounds, q.mPalette)
+
+	for y := 0; y < bounds.Dy(); y++ {
+		row := frame.Pix[y*frame.Stride:]
+		for x := 0; x < bounds.Dx(); x++ {
+			//Colors in the palette, like flat backgrounds, are kept as they are
+			exact := q.mTable[int(row[x*4])>>3<<10|int(row[x*4+1])>>3<<5|int(row[x*4+2])>>3]
+			if c := q.mColors[exact]; c.R == row[x*4] && c.G == row[x*4+1] && c.B == row[x*4+2] {
+				paletted.Pix[y*paletted.Stride+x] = exact
+				continue
+			}
+
+			//Spread the threshold over about one palette step
+			offset := (bayer4[y&3][x&3]*2 - 15) * 2
+			r := ditherChannel(row[x*4], offset)
+			g := ditherChannel(row[x*4+1], offset)
+			b := ditherChannel(row[x*4+2], offset)
+
+			paletted.Pix[y*paletted.Stride+x] = q.mTable[r>>3<<10|g>>3<<5|b>>3]
+		}
+	}
+
+	return paletted
+}
+
+//ditherChannel offsets a channel value, keeping it in range
+func ditherChannel(value uint8, offset int) int {
+	v := int(value) + offset
+	if v < 0 {
+		return 0
+	}
+	if v > 255 {
+		return 255
+	}
+
+	return v
+}
+
+//savePNG encodes an image to a PNG file, removing the file if it can't be written
+func savePNG(path string, frame image.Image) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("could not create PNG file: %v", err)
+	}
+
+	if err := png.Encode(file, frame); err != nil {
+		file.Close()
+		os.Remove(path)
+		return fmt.Errorf("could not encode PNG: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(path)
+		return fmt.Errorf("could not write PNG file: %v", err)
+	}
+
+	return nil
+}
+
+//saveGIF encodes an animation to a GIF file, removing the file if it can't be written
+func saveGIF(path string, animation *gif.GIF) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("could not create GIF file: %v", err)
+	}
+
+	if err := gif.EncodeAll(file, animation); err != nil {
+		file.Close()
+		os.Remove(path)
+		return fmt.Errorf("could not encode GIF: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(path)
+		return fmt.Errorf("could not write GIF file: %v", err)
+	}
+
+	return nil
+}
+
+//timestamp gets the current time for file names
+func timestamp() string {
+	return time.Now().Format("20060102-150405.000")
+}
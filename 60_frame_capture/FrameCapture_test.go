@@ -0,0 +1,255 @@
+package main
+
+import (
+	"fmt"
+	"image/gif"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//newTestCapture creates a capture service reading from a small software renderer
+func newTestCapture(t *testing.T, directory string) *FrameCapture {
+	t.Helper()
+
+	surface, err := sdl.CreateRGBSurfaceWithFormat(0, 16, 8, 32, uint32(sdl.PIXELFORMAT_RGBA32))
+	if err != nil {
+		t.Fatalf("could not create surface: %v", err)
+	}
+	t.Cleanup(surface.Free)
+
+	renderer, err := sdl.CreateSoftwareRenderer(surface)
+	if err != nil {
+		t.Fatalf("could not create software renderer: %v", err)
+	}
+	t.Cleanup(func() { renderer.Destroy() })
+
+	return NewFrameCapture(renderer, directory)
+}
+
+//readGIF decodes a recorded GIF
+func readGIF(t *testing.T, path string) *gif.GIF {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("could not open recording: %v", err)
+	}
+	defer file.Close()
+
+	animation, err := gif.DecodeAll(file)
+	if err != nil {
+		t.Fatalf("could not decode recording: %v", err)
+	}
+
+	return animation
+}
+
+func TestHeadlessRun(t *testing.T) {
+	//Same as running with -frames 10 -record gif
+	*headlessFrames = 10
+	*captureDir = t.TempDir()
+	defer func() { *headlessFrames = 0 }()
+
+	if err := initSDl(); err != nil {
+		t.Fatal(err)
+	}
+	if err := loadMedia(); err != nil {
+		t.Fatal(err)
+	}
+
+	gCapture = NewFrameCapture(gRenderer, *captureDir)
+	gCapture.SetFrameDelay(20)
+	if err := gCapture.StartRecording(captureGIF); err != nil {
+		t.Fatal(err)
+	}
+
+	dot := NewDot()
+	for frame := 0; frame < *headlessFrames; frame++ {
+		if err := renderFrame(dot); err != nil {
+			t.Fatal(err)
+		}
+		if err := gCapture.CaptureFrame(); err != nil {
+			t.Fatal(err)
+		}
+		gRenderer.Present()
+	}
+
+	screenshot, err := gCapture.Screenshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	recording, err := gCapture.StopRecording()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := close(); err != nil {
+		t.Fatal(err)
+	}
+
+	//Screenshot is the whole screen with the white background
+	file, err := os.Open(screenshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	shot, err := png.Decode(file)
+	if err != nil {
+		t.Fatalf("could not decode screenshot: %v", err)
+	}
+	if bounds := shot.Bounds(); bounds.Dx() != screenWitdh || bounds.Dy() != screenHeight {
+		t.Errorf("screenshot is %v, want %dx%d", bounds, screenWitdh, screenHeight)
+	}
+	if r, g, b, _ := shot.At(screenWitdh-1, screenHeight-1).RGBA(); r>>8 != 255 || g>>8 != 255 || b>>8 != 255 {
+		t.Errorf("background is %d,%d,%d, want white", r>>8, g>>8, b>>8)
+	}
+
+	//Every frame recorded at 50 frames per second
+	animation := readGIF(t, recording)
+	if len(animation.Image) != 10 {
+		t.Fatalf("recorded %d frames, want 10", len(animation.Image))
+	}
+	for i, delay := range animation.Delay {
+		if delay != 2 {
+			t.Errorf("frame %d lasts %d hundredths, want 2", i, delay)
+		}
+	}
+}
+
+func TestGIFDelaysDontDrift(t *testing.T) {
+	capture := newTestCapture(t, t.TempDir())
+
+	//25ms doesn't fit in hundredths, alternate 2 and 3 so the total stays right
+	capture.SetFrameDelay(25)
+	if err := capture.StartRecording(captureGIF); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 41; i++ {
+		if err := capture.CaptureFrame(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	path, err := capture.StopRecording()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//The last frame's delay is never known, leave it out
+	animation := readGIF(t, path)
+	total := 0
+	for _, delay := range animation.Delay[:len(animation.Delay)-1] {
+		if delay != 2 && delay != 3 {
+			t.Errorf("frame delay %d, want 2 or 3", delay)
+		}
+		total += delay
+	}
+	if total != 40*25/10 {
+		t.Errorf("40 frames last %d hundredths, want %d", total, 40*25/10)
+	}
+}
+
+func TestEmptyRecording(t *testing.T) {
+	for _, mode := range []CaptureMode{captureGIF, capturePNGSequence} {
+		directory := t.TempDir()
+		capture := newTestCapture(t, directory)
+
+		if err := capture.StartRecording(mode); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := capture.StopRecording(); err == nil {
+			t.Errorf("%v: stopping with no frames should fail", mode)
+		}
+
+		//Nothing is left behind
+		files, err := filepath.Glob(filepath.Join(directory, "*"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != 0 {
+			t.Errorf("%v: empty recording left %v", mode, files)
+		}
+		if capture.IsRecording() {
+			t.Errorf("%v: still recording", mode)
+		}
+	}
+}
+
+func TestPNGSequence(t *testing.T) {
+	capture := newTestCapture(t, t.TempDir())
+
+	//A different color every frame
+	colors := []sdl.Color{{R: 255, A: 255}, {G: 255, A: 255}, {B: 255, A: 255}}
+	if err := capture.StartRecording(capturePNGSequence); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range colors {
+		if err := capture.mRenderer.SetDrawColor(c.R, c.G, c.B, c.A); err != nil {
+			t.Fatal(err)
+		}
+		if err := capture.mRenderer.Clear(); err != nil {
+			t.Fatal(err)
+		}
+		if err := capture.CaptureFrame(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	directory, err := capture.StopRecording()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//One numbered PNG per frame, nothing else
+	files, err := filepath.Glob(filepath.Join(directory, "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != len(colors) {
+		t.Fatalf("sequence has %v, want %d frames", files, len(colors))
+	}
+
+	for i, c := range colors {
+		file, err := os.Open(filepath.Join(directory, fmt.Sprintf("frame-%05d.png", i)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		frame, err := png.Decode(file)
+		file.Close()
+		if err != nil {
+			t.Fatalf("could not decode frame %d: %v", i, err)
+		}
+
+		if bounds := frame.Bounds(); bounds.Dx() != 16 || bounds.Dy() != 8 {
+			t.Errorf("frame %d is %v, want 16x8", i, bounds)
+		}
+		if r, g, b, _ := frame.At(15, 7).RGBA(); uint8(r>>8) != c.R || uint8(g>>8) != c.G || uint8(b>>8) != c.B {
+			t.Errorf("frame %d is %d,%d,%d, want %d,%d,%d", i, r>>8, g>>8, b>>8, c.R, c.G, c.B)
+		}
+	}
+}
+
+func TestGIFFrameLimit(t *testing.T) {
+	capture := newTestCapture(t, t.TempDir())
+	capture.mMaxGIFFrames = 5
+	capture.SetFrameDelay(20)
+
+	if err := capture.StartRecording(captureGIF); err != nil {
+		t.Fatal(err)
+	}
+	path := capture.mPath
+
+	//Recording is saved once the limit is reached and later frames are ignored
+	for i := 0; i < 8; i++ {
+		if err := capture.CaptureFrame(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if capture.IsRecording() {
+		t.Fatal("still recording past the frame limit")
+	}
+	if animation := readGIF(t, path); len(animation.Image) != 5 {
+		t.Errorf("recorded %d frames, want 5", len(animation.Image))
+	}
+}
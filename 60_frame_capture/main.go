@@ -0,0 +1,319 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/veandco/go-sdl2/img"
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/veandco/go-sdl2/ttf"
+)
+
+//Screen dimension constants
+const (
+	screenWitdh  = 640
+	screenHeight = 480
+)
+
+var (
+	//The window we'll be rendering to
+	gWindow *sdl.Window
+
+	//The window renderer
+	gRenderer *sdl.Renderer
+
+	//Surface rendered to instead of the window in headless runs
+	gHeadlessSurface *sdl.Surface
+
+	//Globally used font
+	gFont *ttf.Font
+
+	//Scene textures
+	gDotTexture     LTexture
+	gRedTexture     LTexture
+	gGreenTexture   LTexture
+	gBlueTexture    LTexture
+	gShimmerTexture LTexture
+
+	//Saves screenshots and recordings
+	gCapture *FrameCapture
+
+	//Capture options
+	captureDir     = flag.String("capture-dir", "captures", "directory screenshots and recordings are saved in")
+	recordMode     = flag.String("record", "", "start recording right away, as gif or png")
+	headlessFrames = flag.Int("frames", 0, "render this many frames without a window, save a screenshot and quit")
+)
+
+func main() {
+	flag.Parse()
+
+	//Start up SDL and create window
+	if err := initSDl(); err != nil {
+		log.Fatalf("Could not init SDL: %v\n", err)
+	}
+
+	//Load media
+	if err := loadMedia(); err != nil {
+		log.Fatalf("Could not load media: %v\n", err)
+	}
+
+	//Set up capturing
+	gCapture = NewFrameCapture(gRenderer, *captureDir)
+	if *headlessFrames > 0 {
+		//Headless frames come as fast as they can be drawn, record them at 50 frames per second
+		gCapture.SetFrameDelay(20)
+	}
+	switch *recordMode {
+	case "":
+		break
+	case "gif":
+		if err := gCapture.StartRecording(captureGIF); err != nil {
+			log.Fatalf("Could not start recording: %v\n", err)
+		}
+		break
+	case "png":
+		if err := gCapture.StartRecording(capturePNGSequence); err != nil {
+			log.Fatalf("Could not start recording: %v\n", err)
+		}
+		break
+	default:
+		log.Fatalf("Unknown recording format %q, use gif or png\n", *recordMode)
+	}
+
+	//Main loop flag
+	var quit bool
+
+	//Frames rendered so far
+	var frame int
+
+	//Caption shown in the window title
+	var caption string
+
+	//Event handler
+	var e sdl.Event
+
+	//The dot that will be moving around on the screen
+	var dot = NewDot()
+
+	//While application is running
+	for !quit {
+		//Handle events on queue
+		for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+			//User requests quit
+			if e.GetType() == sdl.QUIT {
+				quit = true
+			}
+
+			//Take screenshots and recordings
+			if err := gCapture.HandleEvent(e); err != nil {
+				fmt.Printf("Warning: %v\n", err)
+			}
+
+			//Handle input for the dot
+			dot.HandleEvent(e)
+		}
+
+		//Show the recording state
+		newCaption := "SDL Tutorial - F12 screenshot, F11 GIF, F10 PNG sequence"
+		if gCapture.IsRecording() {
+			newCaption = fmt.Sprintf("SDL Tutorial - Recording %v: %d frames", gCapture.Mode(), gCapture.Frames())
+		}
+		if newCaption != caption && gWindow != nil {
+			caption = newCaption
+			gWindow.SetTitle(caption)
+		}
+
+		//Move and draw the dot
+		if err := renderFrame(dot); err != nil {
+			log.Fatalf("%v\n", err)
+		}
+
+		//Capture the frame before it's presented
+		if err := gCapture.CaptureFrame(); err != nil {
+			log.Fatalf("%v\n", err)
+		}
+
+		//Finish headless runs
+		frame++
+		if frame == *headlessFrames {
+			path, err := gCapture.Screenshot()
+			if err != nil {
+				log.Fatalf("%v\n", err)
+			}
+			fmt.Printf("Saved screenshot %v\n", path)
+			quit = true
+		}
+
+		//Update screen
+		gRenderer.Present()
+	}
+
+	//Save unfinished recording
+	if gCapture.IsRecording() {
+		if path, err := gCapture.StopRecording(); err != nil {
+			fmt.Printf("Warning: %v\n", err)
+		} else {
+			fmt.Printf("Saved %v recording %v\n", gCapture.Mode(), path)
+		}
+	}
+
+	//Free resources and close SDL
+	if err := close(); err != nil {
+		log.Fatalf("Could not close SDL! SDL Error: %v\n", err)
+	}
+}
+
+//renderFrame moves the dot and draws the scene without presenting it
+func renderFrame(dot *Dot) error {
+	//Move the dot
+	dot.Move()
+
+	//Clear screen
+	if err := gRenderer.SetDrawColor(255, 255, 255, 255); err != nil {
+		return fmt.Errorf("could not set draw color for renderer: %v", err)
+	}
+	if err := gRenderer.Clear(); err != nil {
+		return fmt.Errorf("could not clear renderer: %v", err)
+	}
+
+	//Render objects
+	return dot.Render()
+}
+
+func initSDl() error {
+	//Local error declaration
+	var err error
+
+	//Headless runs draw in software to a surface in memory so they work without a display
+	if *headlessFrames > 0 {
+		//Initialize SDL without video
+		if err := sdl.Init(sdl.INIT_EVENTS); err != nil {
+			return fmt.Errorf("SDL could not initialize! SDL_ERROR: %v", err)
+		}
+
+		//Create surface to render to
+		gHeadlessSurface, err = sdl.CreateRGBSurfaceWithFormat(0, screenWitdh, screenHeight, 32, uint32(sdl.PIXELFORMAT_RGBA32))
+		if err != nil {
+			return fmt.Errorf("Headless surface could not be created! SDL Error: %v", err)
+		}
+
+		//Create software renderer for surface
+		if gRenderer, err = sdl.CreateSoftwareRenderer(gHeadlessSurface); err != nil {
+			return fmt.Errorf("Renderer could not be created! SDL Error: %v", err)
+		}
+	} else {
+		//Initialize SDL
+		if err := sdl.Init(sdl.INIT_VIDEO); err != nil {
+			return fmt.Errorf("SDL could not initialize! SDL_ERROR: %v", err)
+		}
+
+		//Set texture filtering to linear
+		if !sdl.SetHint(sdl.HINT_RENDER_SCALE_QUALITY, "1") {
+			fmt.Printf("Warning: Linear texture filtering not enabled!")
+		}
+
+		//Create Window
+		gWindow, err = sdl.CreateWindow("SDL Tutorial", sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED,
+			screenWitdh, screenHeight, sdl.WINDOW_SHOWN)
+		if err != nil {
+			return fmt.Errorf("Window could not be created! SDL_Error: %v", err)
+		}
+
+		//Create vsynced renderer for window
+		if gRenderer, err = sdl.CreateRenderer(gWindow, -1, sdl.RENDERER_ACCELERATED|sdl.RENDERER_PRESENTVSYNC); err != nil {
+			return fmt.Errorf("Renderer could not be created! SDL Error: %v", err)
+		}
+	}
+
+	//Initialize renderer color
+	gRenderer.SetDrawColor(255, 255, 255, 255)
+
+	//Initialize PNG loading
+	imgFlags := img.INIT_PNG
+	if (img.Init(imgFlags) & imgFlags) == 0 {
+		return fmt.Errorf("SDL_image could not initialize! SDL_image Error: %v", img.GetError())
+	}
+
+	//Initialize SDL_ttf
+	if err := ttf.Init(); err != nil {
+		return fmt.Errorf("SDL_ttf could not initialize! SDL_ttf Error: %v", err)
+	}
+
+	return nil
+}
+
+func loadMedia() error {
+	var err error
+
+	//Load dot texture
+	if err = gDotTexture.LoadFromFile("dot.bmp"); err != nil {
+		return fmt.Errorf("Failed to load dot texture: %v", err)
+	}
+
+	//Load red texture
+	if err = gRedTexture.LoadFromFile("red.bmp"); err != nil {
+		return fmt.Errorf("Failed to load red texture: %v", err)
+	}
+
+	//Load green texture
+	if err = gGreenTexture.LoadFromFile("green.bmp"); err != nil {
+		return fmt.Errorf("Failed to load green texture: %v", err)
+	}
+
+	//Load blue texture
+	if err = gBlueTexture.LoadFromFile("blue.bmp"); err != nil {
+		return fmt.Errorf("Failed to load blue texture: %v", err)
+	}
+
+	//Load shimmer texture
+	if err = gShimmerTexture.LoadFromFile("shimmer.bmp"); err != nil {
+		return fmt.Errorf("Failed to load shimmer texture: %v", err)
+	}
+
+	//Set texture transparency
+	if err = gRedTexture.SetAlpha(192); err != nil {
+		return fmt.Errorf("could not set red texture's alpha")
+	}
+	if err = gGreenTexture.SetAlpha(192); err != nil {
+		return fmt.Errorf("could not set green texture's alpha")
+	}
+	if err = gBlueTexture.SetAlpha(192); err != nil {
+		return fmt.Errorf("could not set blue texture's alpha")
+	}
+	if err = gShimmerTexture.SetAlpha(192); err != nil {
+		return fmt.Errorf("could not set shimmer texture's alpha")
+	}
+
+	return nil
+}
+
+func close() error {
+	//Free loaded images
+	if err := gDotTexture.Free(); err != nil {
+		return fmt.Errorf("could not free dot texture: %v", err)
+	}
+
+	//Destroy window
+	if err := gRenderer.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy global renderer: %v", err)
+	}
+	if gWindow != nil {
+		if err := gWindow.Destroy(); err != nil {
+			return fmt.Errorf("Could not destroy window: %v", err)
+		}
+	}
+	if gHeadlessSurface != nil {
+		gHeadlessSurface.Free()
+	}
+	gWindow = nil
+	gHeadlessSurface = nil
+	gRenderer = nil
+
+	//Quit SDL Subsystems
+	ttf.Quit()
+	img.Quit()
+	sdl.Quit()
+
+	return nil
+}
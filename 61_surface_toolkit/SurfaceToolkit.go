@@ -0,0 +1,422 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//The toolkit works on image.RGBA holding straight alpha, the way SDL surfaces store pixels
+//Every operation returns a new image starting at the origin and leaves the source untouched
+
+//ResizeFilter is how pixels are sampled when resizing
+type ResizeFilter int
+
+//Resize filters
+const (
+	resizeNearest ResizeFilter = iota
+	resizeBilinear
+	resizeBicubic
+)
+
+//String gets the name of the resize filter
+func (f ResizeFilter) String() string {
+	switch f {
+	case resizeNearest:
+		return "nearest"
+	case resizeBilinear:
+		return "bilinear"
+	case resizeBicubic:
+		return "bicubic"
+	}
+
+	return "unknown"
+}
+
+//SurfaceToImage copies a surface of any format into an image
+func SurfaceToImage(surface *sdl.Surface) (*image.RGBA, error) {
+	//image.RGBA stores bytes in the same order as SDL's RGBA32
+	converted, err := surface.ConvertFormat(uint32(sdl.PIXELFORMAT_RGBA32), 0)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert surface to RGBA: %v", err)
+	}
+	defer converted.Free()
+
+	if converted.MustLock() {
+		if err := converted.Lock(); err != nil {
+			return nil, fmt.Errorf("could not lock surface: %v", err)
+		}
+		defer converted.Unlock()
+	}
+
+	//Copy row by row since the surface rows may be padded
+	width, height := int(converted.W), int(converted.H)
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	pixels := converted.Pixels()
+	for y := 0; y < height; y++ {
+		copy(img.Pix[y*img.Stride:y*img.Stride+width*4], pixels[y*int(converted.Pitch):])
+	}
+
+	return img, nil
+}
+
+//ImageToSurface copies an image into a new RGBA surface
+func ImageToSurface(img *image.RGBA) (*sdl.Surface, error) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	surface, err := sdl.CreateRGBSurfaceWithFormat(0, int32(width), int32(height), 32, uint32(sdl.PIXELFORMAT_RGBA32))
+	if err != nil {
+		return nil, fmt.Errorf("could not create surface: %v", err)
+	}
+
+	if surface.MustLock() {
+		if err := surface.Lock(); err != nil {
+			surface.Free()
+			return nil, fmt.Errorf("could not lock surface: %v", err)
+		}
+		defer surface.Unlock()
+	}
+
+	pixels := surface.Pixels()
+	for y := 0; y < height; y++ {
+		i := img.PixOffset(bounds.Min.X, bounds.Min.Y+y)
+		copy(pixels[y*int(surface.Pitch):], img.Pix[i:i+width*4])
+	}
+
+	return surface, nil
+}
+
+//Resize scales an image to the given size
+//Bilinear and bicubic filtering blend in premultiplied alpha so transparent pixels don't bleed their color into the edges
+func Resize(src *image.RGBA, width, height int, filter ResizeFilter) *image.RGBA {
+	switch filter {
+	case resizeBilinear:
+		return resample(src, width, height, 1, triangleWeight)
+	case resizeBicubic:
+		return resample(src, width, height, 2, catmullRomWeight)
+	}
+
+	return resizeNearestNeighbour(src, width, height)
+}
+
+//Rotate90 turns an image a quarter turn clockwise
+func Rotate90(src *image.RGBA) *image.RGBA {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	return remap(src, height, width, func(x, y int) (int, int) {
+		return y, height - 1 - x
+	})
+}
+
+//Rotate180 turns an image upside down
+func Rotate180(src *image.RGBA) *image.RGBA {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	return remap(src, width, height, func(x, y int) (int, int) {
+		return width - 1 - x, height - 1 - y
+	})
+}
+
+//Rotate270 turns an image a quarter turn counter clockwise
+func Rotate270(src *image.RGBA) *image.RGBA {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	return remap(src, height, width, func(x, y int) (int, int) {
+		return width - 1 - y, x
+	})
+}
+
+//FlipHorizontal mirrors an image left to right
+func FlipHorizontal(src *image.RGBA) *image.RGBA {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	return remap(src, width, height, func(x, y int) (int, int) {
+		return width - 1 - x, y
+	})
+}
+
+//FlipVertical mirrors an image top to bottom
+func FlipVertical(src *image.RGBA) *image.RGBA {
+	bounds := src.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	return remap(src, width, height, func(x, y int) (int, int) {
+		return x, height - 1 - y
+	})
+}
+
+//SwapPalette replaces colors that exactly match a key in the map, like turning a color key into transparency
+func SwapPalette(src *image.RGBA, swaps map[color.RGBA]color.RGBA) *image.RGBA {
+	return mapColors(src, func(c color.RGBA) color.RGBA {
+		if swap, ok := swaps[c]; ok {
+			return swap
+		}
+		return c
+	})
+}
+
+//AdjustHueSaturation rotates hue by the given degrees and multiplies saturation, keeping lightness so grays stay as bright
+func AdjustHueSaturation(src *image.RGBA, hueShift, saturation float64) *image.RGBA {
+	return mapColors(src, func(c color.RGBA) color.RGBA {
+		h, s, l := rgbToHSL(c.R, c.G, c.B)
+
+		h = math.Mod(h+hueShift, 360)
+		if h < 0 {
+			h += 360
+		}
+		s = math.Min(s*saturation, 1)
+
+		r, g, b := hslToRGB(h, s, l)
+		return color.RGBA{R: r, G: g, B: b, A: c.A}
+	})
+}
+
+//Premultiply multiplies every color by its alpha for premultiplied blending
+func Premultiply(src *image.RGBA) *image.RGBA {
+	return mapColors(src, func(c color.RGBA) color.RGBA {
+		return color.RGBA{
+			R: uint8((int(c.R)*int(c.A) + 127) / 255),
+			G: uint8((int(c.G)*int(c.A) + 127) / 255),
+			B: uint8((int(c.B)*int(c.A) + 127) / 255),
+			A: c.A,
+		}
+	})
+}
+
+//Unpremultiply divides every color by its alpha, undoing Premultiply as far as precision allows
+func Unpremultiply(src *image.RGBA) *image.RGBA {
+	return mapColors(src, func(c color.RGBA) color.RGBA {
+		if c.A == 0 {
+			return color.RGBA{}
+		}
+
+		alpha := float64(c.A)
+		return color.RGBA{
+			R: clampChannel(float64(c.R) * 255 / alpha),
+			G: clampChannel(float64(c.G) * 255 / alpha),
+			B: clampChannel(float64(c.B) * 255 / alpha),
+			A: c.A,
+		}
+	})
+}
+
+//resizeNearestNeighbour picks the source pixel under the center of every destination pixel
+func resizeNearestNeighbour(src *image.RGBA, width, height int) *image.RGBA {
+	bounds := src.Bounds()
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+
+	//Nothing to pick from, leave it transparent like resample does
+	if srcWidth == 0 || srcHeight == 0 {
+		return image.NewRGBA(image.Rect(0, 0, width, height))
+	}
+
+	return remap(src, width, height, func(x, y int) (int, int) {
+		return (2*x + 1) * srcWidth / (2 * width), (2*y + 1) * srcHeight / (2 * height)
+	})
+}
+
+//resample filters the source with a kernel reaching radius pixels either side of the sample point
+func resample(src *image.RGBA, width, height, radius int, weight func(float64) float64) *image.RGBA {
+	bounds := src.Bounds()
+	srcWidth, srcHeight := bounds.Dx(), bounds.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	if srcWidth == 0 || srcHeight == 0 {
+		return dst
+	}
+
+	scaleX := float64(srcWidth) / float64(width)
+	scaleY := float64(srcHeight) / float64(height)
+
+	for y := 0; y < height; y++ {
+		//Map pixel centers back to the source
+		sy := (float64(y)+0.5)*scaleY - 0.5
+		baseY := int(math.Floor(sy))
+
+		for x := 0; x < width; x++ {
+			sx := (float64(x)+0.5)*scaleX - 0.5
+			baseX := int(math.Floor(sx))
+
+			//Sum premultiplied channels under the kernel
+			var r, g, b, a, total float64
+			for ky := baseY - radius + 1; ky <= baseY+radius; ky++ {
+				wy := weight(sy - float64(ky))
+				row := clampInt(ky, 0, srcHeight-1)
+
+				for kx := baseX - radius + 1; kx <= baseX+radius; kx++ {
+					w := wy * weight(sx-float64(kx))
+					i := src.PixOffset(bounds.Min.X+clampInt(kx, 0, srcWidth-1), bounds.Min.Y+row)
+
+					alpha := float64(src.Pix[i+3]) * w
+					r += float64(src.Pix[i]) * alpha
+					g += float64(src.Pix[i+1]) * alpha
+					b += float64(src.Pix[i+2]) * alpha
+					a += alpha
+					total += w
+				}
+			}
+
+			//Back to straight alpha, bicubic can overshoot so clamp everything
+			i := dst.PixOffset(x, y)
+			if a <= 0 {
+				continue
+			}
+			dst.Pix[i] = clampChannel(r / a)
+			dst.Pix[i+1] = clampChannel(g / a)
+			dst.Pix[i+2] = clampChannel(b / a)
+			dst.Pix[i+3] = clampChannel(a / total)
+		}
+	}
+
+	return dst
+}
+
+//remap builds an image by copying each destination pixel from a source position
+func remap(src *image.RGBA, width, height int, source func(x, y int) (int, int)) *image.RGBA {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			sx, sy := source(x, y)
+			i := src.PixOffset(bounds.Min.X+sx, bounds.Min.Y+sy)
+			copy(dst.Pix[dst.PixOffset(x, y):], src.Pix[i:i+4])
+		}
+	}
+
+	return dst
+}
+
+//mapColors builds an image by passing every pixel through a function
+func mapColors(src *image.RGBA, recolor func(color.RGBA) color.RGBA) *image.RGBA {
+	bounds := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := 0; x < bounds.Dx(); x++ {
+			i := src.PixOffset(bounds.Min.X+x, bounds.Min.Y+y)
+			c := recolor(color.RGBA{R: src.Pix[i], G: src.Pix[i+1], B: src.Pix[i+2], A: src.Pix[i+3]})
+
+			j := dst.PixOffset(x, y)
+			dst.Pix[j], dst.Pix[j+1], dst.Pix[j+2], dst.Pix[j+3] = c.R, c.G, c.B, c.A
+		}
+	}
+
+	return dst
+}
+
+//triangleWeight is the bilinear kernel
+func triangleWeight(d float64) float64 {
+	d = math.Abs(d)
+	if d >= 1 {
+		return 0
+	}
+
+	return 1 - d
+}
+
+//catmullRomWeight is the bicubic kernel, it passes through the source pixels and keeps edges sharp
+func catmullRomWeight(d float64) float64 {
+	d = math.Abs(d)
+	if d < 1 {
+		return 1.5*d*d*d - 2.5*d*d + 1
+	}
+	if d < 2 {
+		return -0.5*d*d*d + 2.5*d*d - 4*d + 2
+	}
+
+	return 0
+}
+
+//rgbToHSL converts a color to hue in degrees and saturation and lightness from 0 to 1
+func rgbToHSL(r, g, b uint8) (float64, float64, float64) {
+	rf, gf, bf := float64(r)/255, float64(g)/255, float64(b)/255
+	max := math.Max(rf, math.Max(gf, bf))
+	min := math.Min(rf, math.Min(gf, bf))
+	delta := max - min
+	l := (max + min) / 2
+
+	//Grays have no hue or saturation
+	if delta == 0 {
+		return 0, 0, l
+	}
+
+	var h float64
+	switch max {
+	case rf:
+		h = math.Mod((gf-bf)/delta, 6)
+		break
+	case gf:
+		h = (bf-rf)/delta + 2
+		break
+	default:
+		h = (rf-gf)/delta + 4
+	}
+	h *= 60
+	if h < 0 {
+		h += 360
+	}
+
+	return h, delta / (1 - math.Abs(2*l-1)), l
+}
+
+//hslToRGB converts hue in degrees and saturation and lightness from 0 to 1 back to a color
+func hslToRGB(h, s, l float64) (uint8, uint8, uint8) {
+	c := (1 - math.Abs(2*l-1)) * s
+	x := c * (1 - math.Abs(math.Mod(h/60, 2)-1))
+	m := l - c/2
+
+	var r, g, b float64
+	switch int(h / 60) {
+	case 0:
+		r, g, b = c, x, 0
+		break
+	case 1:
+		r, g, b = x, c, 0
+		break
+	case 2:
+		r, g, b = 0, c, x
+		break
+	case 3:
+		r, g, b = 0, x, c
+		break
+	case 4:
+		r, g, b = x, 0, c
+		break
+	default:
+		r, g, b = c, 0, x
+	}
+
+	return clampChannel((r + m) * 255), clampChannel((g + m) * 255), clampChannel((b + m) * 255)
+}
+
+//clampChannel rounds a channel value into the 0 to 255 range
+func clampChannel(value float64) uint8 {
+	if value <= 0 {
+		return 0
+	}
+	if value >= 255 {
+		return 255
+	}
+
+	return uint8(value + 0.5)
+}
+
+//clampInt keeps a value in a range
+func clampInt(value, low, high int) int {
+	if value < low {
+		return low
+	}
+	if value > high {
+		return high
+	}
+
+	return value
+}
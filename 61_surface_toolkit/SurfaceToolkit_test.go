@@ -0,0 +1,226 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"reflect"
+	"testing"
+)
+
+//Colors used by the test images
+var (
+	testRed         = color.RGBA{R: 255, A: 255}
+	testGreen       = color.RGBA{G: 255, A: 255}
+	testBlue        = color.RGBA{B: 255, A: 255}
+	testWhite       = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	testBlack       = color.RGBA{A: 255}
+	testGray        = color.RGBA{R: 128, G: 128, B: 128, A: 255}
+	testTransparent = color.RGBA{R: 255}
+)
+
+//newTestImage creates an image from rows of colors
+func newTestImage(rows [][]color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, len(rows[0]), len(rows)))
+	for y, row := range rows {
+		for x, c := range row {
+			img.SetRGBA(x, y, c)
+		}
+	}
+
+	return img
+}
+
+//imageRows gets the colors of an image row by row
+func imageRows(img *image.RGBA) [][]color.RGBA {
+	bounds := img.Bounds()
+	rows := make([][]color.RGBA, bounds.Dy())
+	for y := range rows {
+		rows[y] = make([]color.RGBA, bounds.Dx())
+		for x := range rows[y] {
+			rows[y][x] = img.RGBAAt(bounds.Min.X+x, bounds.Min.Y+y)
+		}
+	}
+
+	return rows
+}
+
+//checkImage fails the test if an image doesn't start at the origin with the expected colors
+func checkImage(t *testing.T, name string, got *image.RGBA, want [][]color.RGBA) {
+	t.Helper()
+
+	if got.Bounds().Min != (image.Point{}) {
+		t.Errorf("%v: image starts at %v, want the origin", name, got.Bounds().Min)
+	}
+	if rows := imageRows(got); !reflect.DeepEqual(rows, want) {
+		t.Errorf("%v: got %v, want %v", name, rows, want)
+	}
+}
+
+func TestResize(t *testing.T) {
+	blackWhite := newTestImage([][]color.RGBA{{testBlack, testWhite}})
+	fourColors := newTestImage([][]color.RGBA{{testRed, testGreen, testBlue, testWhite}})
+
+	//Only the blue half has any weight, so the transparent red never shows
+	halfTransparent := newTestImage([][]color.RGBA{{testTransparent, testBlue}})
+
+	tests := []struct {
+		mName   string
+		mSrc    *image.RGBA
+		mWidth  int
+		mHeight int
+		mFilter ResizeFilter
+		mWant   [][]color.RGBA
+	}{
+		{"nearest up", blackWhite, 4, 2, resizeNearest, [][]color.RGBA{
+			{testBlack, testBlack, testWhite, testWhite},
+			{testBlack, testBlack, testWhite, testWhite},
+		}},
+		{"nearest down", fourColors, 2, 1, resizeNearest, [][]color.RGBA{{testGreen, testWhite}}},
+		{"bilinear up", blackWhite, 4, 1, resizeBilinear, [][]color.RGBA{{
+			testBlack, {R: 64, G: 64, B: 64, A: 255}, {R: 191, G: 191, B: 191, A: 255}, testWhite,
+		}}},
+		{"bilinear transparent edge", halfTransparent, 4, 1, resizeBilinear, [][]color.RGBA{{
+			{}, {B: 255, A: 64}, {B: 255, A: 191}, testBlue,
+		}}},
+		{"bicubic up", blackWhite, 4, 1, resizeBicubic, [][]color.RGBA{{
+			testBlack, {R: 52, G: 52, B: 52, A: 255}, {R: 203, G: 203, B: 203, A: 255}, testWhite,
+		}}},
+		{"bicubic same size", fourColors, 4, 1, resizeBicubic, [][]color.RGBA{{testRed, testGreen, testBlue, testWhite}}},
+	}
+
+	for _, test := range tests {
+		checkImage(t, test.mName, Resize(test.mSrc, test.mWidth, test.mHeight, test.mFilter), test.mWant)
+	}
+
+	//An empty source gives a transparent image of the asked size with every filter
+	empty := image.NewRGBA(image.Rect(0, 0, 0, 0))
+	for _, filter := range []ResizeFilter{resizeNearest, resizeBilinear, resizeBicubic} {
+		checkImage(t, "empty "+filter.String(), Resize(empty, 2, 1, filter), [][]color.RGBA{{{}, {}}})
+	}
+}
+
+func TestRotateAndFlip(t *testing.T) {
+	//R G B
+	//W K T
+	src := newTestImage([][]color.RGBA{
+		{testRed, testGreen, testBlue},
+		{testWhite, testBlack, testTransparent},
+	})
+
+	//Same pixels inside a bigger image
+	padded := image.NewRGBA(image.Rect(0, 0, 5, 4))
+	for y := 0; y < 2; y++ {
+		for x := 0; x < 3; x++ {
+			padded.SetRGBA(x+1, y+2, src.RGBAAt(x, y))
+		}
+	}
+	sub := padded.SubImage(image.Rect(1, 2, 4, 4)).(*image.RGBA)
+
+	tests := []struct {
+		mName      string
+		mTransform func(*image.RGBA) *image.RGBA
+		mWant      [][]color.RGBA
+	}{
+		{"rotate 90", Rotate90, [][]color.RGBA{
+			{testWhite, testRed},
+			{testBlack, testGreen},
+			{testTransparent, testBlue},
+		}},
+		{"rotate 180", Rotate180, [][]color.RGBA{
+			{testTransparent, testBlack, testWhite},
+			{testBlue, testGreen, testRed},
+		}},
+		{"rotate 270", Rotate270, [][]color.RGBA{
+			{testBlue, testTransparent},
+			{testGreen, testBlack},
+			{testRed, testWhite},
+		}},
+		{"flip horizontal", FlipHorizontal, [][]color.RGBA{
+			{testBlue, testGreen, testRed},
+			{testTransparent, testBlack, testWhite},
+		}},
+		{"flip vertical", FlipVertical, [][]color.RGBA{
+			{testWhite, testBlack, testTransparent},
+			{testRed, testGreen, testBlue},
+		}},
+	}
+
+	for _, test := range tests {
+		checkImage(t, test.mName, test.mTransform(src), test.mWant)
+		checkImage(t, test.mName+" of sub image", test.mTransform(sub), test.mWant)
+	}
+}
+
+func TestColorOperations(t *testing.T) {
+	src := newTestImage([][]color.RGBA{{testRed, testGray, {R: 255, A: 128}, testTransparent}})
+
+	tests := []struct {
+		mName      string
+		mTransform func(*image.RGBA) *image.RGBA
+		mWant      [][]color.RGBA
+	}{
+		{"swap palette", func(img *image.RGBA) *image.RGBA {
+			return SwapPalette(img, map[color.RGBA]color.RGBA{testRed: testBlue, testTransparent: {}})
+		}, [][]color.RGBA{{testBlue, testGray, {R: 255, A: 128}, {}}}},
+		{"hue forward", func(img *image.RGBA) *image.RGBA {
+			return AdjustHueSaturation(img, 120, 1)
+		}, [][]color.RGBA{{testGreen, testGray, {G: 255, A: 128}, {G: 255}}}},
+		{"hue backward", func(img *image.RGBA) *image.RGBA {
+			return AdjustHueSaturation(img, -120, 1)
+		}, [][]color.RGBA{{testBlue, testGray, {B: 255, A: 128}, {B: 255}}}},
+		{"desaturate", func(img *image.RGBA) *image.RGBA {
+			return AdjustHueSaturation(img, 0, 0)
+		}, [][]color.RGBA{{testGray, testGray, {R: 128, G: 128, B: 128, A: 128}, {R: 128, G: 128, B: 128}}}},
+		{"half saturation", func(img *image.RGBA) *image.RGBA {
+			return AdjustHueSaturation(img, 0, 0.5)
+		}, [][]color.RGBA{{{R: 191, G: 64, B: 64, A: 255}, testGray, {R: 191, G: 64, B: 64, A: 128}, {R: 191, G: 64, B: 64}}}},
+		{"premultiply", Premultiply, [][]color.RGBA{{testRed, testGray, {R: 128, A: 128}, {}}}},
+	}
+
+	for _, test := range tests {
+		checkImage(t, test.mName, test.mTransform(src), test.mWant)
+	}
+
+	//The source is never changed
+	checkImage(t, "source", src, [][]color.RGBA{{testRed, testGray, {R: 255, A: 128}, testTransparent}})
+}
+
+func TestPremultiplyRoundTrip(t *testing.T) {
+	//Every channel value at a spread of alphas
+	src := image.NewRGBA(image.Rect(0, 0, 256, 6))
+	alphas := []uint8{0, 1, 17, 128, 254, 255}
+	for y, alpha := range alphas {
+		for x := 0; x < 256; x++ {
+			src.SetRGBA(x, y, color.RGBA{R: uint8(x), G: uint8(255 - x), B: uint8(x / 2), A: alpha})
+		}
+	}
+
+	got := Unpremultiply(Premultiply(src))
+
+	for y, alpha := range alphas {
+		for x := 0; x < 256; x++ {
+			want := src.RGBAAt(x, y)
+			c := got.RGBAAt(x, y)
+
+			//Fully transparent pixels lose their color
+			if alpha == 0 {
+				if c != (color.RGBA{}) {
+					t.Errorf("alpha 0 at %d: got %v, want transparent black", x, c)
+				}
+				continue
+			}
+
+			//Premultiplying keeps alpha steps of color, so rounding can be off by up to half a step
+			tolerance := 255/(2*int(alpha)) + 1
+			if c.A != alpha || !near(c.R, want.R, tolerance) || !near(c.G, want.G, tolerance) || !near(c.B, want.B, tolerance) {
+				t.Errorf("round trip of %v = %v, want within %d", want, c, tolerance)
+			}
+		}
+	}
+}
+
+//near checks if two channel values are within a tolerance
+func near(a, b uint8, tolerance int) bool {
+	d := int(a) - int(b)
+	return d <= tolerance && d >= -tolerance
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"log"
+
+	"github.com/veandco/go-sdl2/img"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	//Screen dimension constants
+	screenWitdh  = 640
+	screenHeight = 480
+
+	//Number of sprite variants generated at load time
+	totalVariants = 13
+)
+
+var (
+	//The window we'll be rendering to
+	gWindow *sdl.Window
+
+	//The window renderer
+	gRenderer *sdl.Renderer
+
+	//Sprite variants and where they are shown
+	gVariantTextures  [totalVariants]LTexture
+	gVariantPositions = [totalVariants]sdl.Point{
+		//Thumbnail, rotations and flips
+		{X: 20, Y: 10}, {X: 140, Y: 10}, {X: 260, Y: 10}, {X: 380, Y: 10}, {X: 500, Y: 10},
+		{X: 20, Y: 130},
+
+		//Palette swap, hue shift, desaturation and premultiplied alpha
+		{X: 140, Y: 130}, {X: 260, Y: 130}, {X: 380, Y: 130}, {X: 500, Y: 130},
+
+		//Tiny dot blown up with each resize filter
+		{X: 20, Y: 260}, {X: 230, Y: 260}, {X: 440, Y: 260},
+	}
+
+	//Cyan background of the sprite sheet
+	gColorKey = color.RGBA{R: 0, G: 255, B: 255, A: 255}
+
+	//Recolors every dot in the sprite sheet
+	gPaletteSwap = map[color.RGBA]color.RGBA{
+		{R: 128, G: 0, B: 0, A: 255}:     {R: 128, G: 0, B: 128, A: 255},
+		{R: 0, G: 128, B: 0, A: 255}:     {R: 255, G: 128, B: 0, A: 255},
+		{R: 255, G: 255, B: 128, A: 255}: {R: 128, G: 255, B: 255, A: 255},
+		{R: 0, G: 0, B: 128, A: 255}:     {R: 64, G: 64, B: 64, A: 255},
+	}
+)
+
+func main() {
+	//Start up SDL and create window
+	if err := initSDl(); err != nil {
+		log.Fatalf("Could not init SDL: %v\n", err)
+	}
+
+	//Load media
+	if err := loadMedia(); err != nil {
+		log.Fatalf("Could not load media: %v\n", err)
+	}
+
+	//Main loop flag
+	quit := false
+
+	//Event handler
+	var e sdl.Event
+
+	//While application is running
+	for !quit {
+		//Handle events on queue
+		for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+			//User requests quit
+			if e.GetType() == sdl.QUIT {
+				quit = true
+			}
+		}
+
+		//Clear screen
+		gRenderer.SetDrawColor(255, 255, 255, 255)
+		gRenderer.Clear()
+
+		//Render every variant
+		for i := range gVariantTextures {
+			if err := gVariantTextures[i].Render(gVariantPositions[i].X, gVariantPositions[i].Y); err != nil {
+				log.Fatalf("%v\n", err)
+			}
+		}
+
+		//Update screen
+		gRenderer.Present()
+	}
+
+	//Free resources and close SDL
+	if err := close(); err != nil {
+		log.Fatalf("Could not close SDL! SDL Error: %v\n", err)
+	}
+}
+
+func initSDl() error {
+	//Local error declaration
+	var err error
+
+	//Initialize SDL
+	if err := sdl.Init(sdl.INIT_VIDEO); err != nil {
+		return fmt.Errorf("SDL could not initialize! SDL_ERROR: %v", err)
+	}
+
+	//Set texture filtering to linear
+	if !sdl.SetHint(sdl.HINT_RENDER_SCALE_QUALITY, "1") {
+		fmt.Printf("Warning: Linear texture filtering not enabled!")
+	}
+
+	//Create Window
+	gWindow, err = sdl.CreateWindow("SDL Tutorial", sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED,
+		screenWitdh, screenHeight, sdl.WINDOW_SHOWN)
+	if err != nil {
+		return fmt.Errorf("Window could not be created! SDL_Error: %v", err)
+	}
+
+	//Create renderer for window
+	if gRenderer, err = sdl.CreateRenderer(gWindow, -1, sdl.RENDERER_ACCELERATED); err != nil {
+		return fmt.Errorf("Renderer could not be created! SDL Error: %v", err)
+	}
+
+	//Initialize renderer color
+	gRenderer.SetDrawColor(255, 255, 255, 255)
+
+	//Initialize PNG loading
+	imgFlags := img.INIT_PNG
+	if (img.Init(imgFlags) & imgFlags) == 0 {
+		return fmt.Errorf("SDL_image could not initialize! SDL_image Error: %v", img.GetError())
+	}
+
+	return nil
+}
+
+func loadMedia() error {
+	//Load sprite sheet pixels
+	loadedSurface, err := img.Load("dots.png")
+	if err != nil {
+		return fmt.Errorf("could not load image dots.png! SDL_image Error: %v", err)
+	}
+	sheet, err := SurfaceToImage(loadedSurface)
+	loadedSurface.Free()
+	if err != nil {
+		return fmt.Errorf("failed to read sprite sheet pixels: %v", err)
+	}
+
+	//Turn the color key into transparency
+	sheet = SwapPalette(sheet, map[color.RGBA]color.RGBA{gColorKey: {}})
+
+	//Shrink the sheet and generate the variants from the thumbnail
+	thumbnail := Resize(sheet, 100, 100, resizeBilinear)
+
+	//Blow a tiny dot up to compare the filters
+	tinyDot := Resize(sheet.SubImage(image.Rect(0, 0, 100, 100)).(*image.RGBA), 20, 20, resizeBilinear)
+
+	variants := [totalVariants]*image.RGBA{
+		thumbnail,
+		Rotate90(thumbnail),
+		Rotate180(thumbnail),
+		Rotate270(thumbnail),
+		FlipHorizontal(thumbnail),
+		FlipVertical(thumbnail),
+
+		//Swap on the full sheet, resized edges no longer match the palette exactly
+		Resize(SwapPalette(sheet, gPaletteSwap), 100, 100, resizeBilinear),
+		AdjustHueSaturation(thumbnail, 180, 1),
+		AdjustHueSaturation(thumbnail, 0, 0),
+		Premultiply(thumbnail),
+
+		Resize(tinyDot, 180, 180, resizeNearest),
+		Resize(tinyDot, 180, 180, resizeBilinear),
+		Resize(tinyDot, 180, 180, resizeBicubic),
+	}
+
+	//Create the textures
+	for i, variant := range variants {
+		if err := gVariantTextures[i].LoadFromImage(variant); err != nil {
+			return fmt.Errorf("failed to create variant texture %d: %v", i, err)
+		}
+	}
+
+	//Premultiplied pixels are already scaled by alpha, so blend with the source color as is
+	premultiplied := sdl.ComposeCustomBlendMode(sdl.BLENDFACTOR_ONE, sdl.BLENDFACTOR_ONE_MINUS_SRC_ALPHA, sdl.BLENDOPERATION_ADD,
+		sdl.BLENDFACTOR_ONE, sdl.BLENDFACTOR_ONE_MINUS_SRC_ALPHA, sdl.BLENDOPERATION_ADD)
+	if err := gVariantTextures[9].SetBlendMode(premultiplied); err != nil {
+		fmt.Printf("Warning: premultiplied blending not supported, edges will look dark: %v\n", err)
+	}
+
+	return nil
+}
+
+func close() error {
+	//Free loaded images
+	for i := range gVariantTextures {
+		if err := gVariantTextures[i].Free(); err != nil {
+			return fmt.Errorf("could not free variant texture %d: %v", i, err)
+		}
+	}
+
+	//Destroy window
+	if err := gRenderer.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy global renderer: %v", err)
+	}
+	if err := gWindow.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy window: %v", err)
+	}
+	gWindow = nil
+	gRenderer = nil
+
+	//Quit SDL Subsystems
+	img.Quit()
+	sdl.Quit()
+	return nil
+}
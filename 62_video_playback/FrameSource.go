@@ -0,0 +1,106 @@
+package main
+
+import (
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//VideoFormat is how the pixels of decoded frames are laid out
+type VideoFormat int
+
+//Frame layouts
+const (
+	//Packed 8 bit RGBA with straight alpha
+	videoRGBA VideoFormat = iota
+
+	//Planar 4:2:0 YUV, a full size Y plane followed by quarter size U and V planes
+	videoYUV420
+)
+
+//String gets the name of the video format
+func (f VideoFormat) String() string {
+	switch f {
+	case videoRGBA:
+		return "RGBA"
+	case videoYUV420:
+		return "YUV 4:2:0"
+	}
+
+	return "unknown"
+}
+
+//PixelFormat gets the SDL texture format frames are uploaded to
+func (f VideoFormat) PixelFormat() uint32 {
+	if f == videoYUV420 {
+		return sdl.PIXELFORMAT_IYUV
+	}
+
+	return uint32(sdl.PIXELFORMAT_RGBA32)
+}
+
+//VideoInfo describes the frames a source produces
+type VideoInfo struct {
+	Width  int
+	Height int
+	Format VideoFormat
+
+	//Native frame rate as a fraction, sources with variable frame times give their average
+	FrameRateNum int
+	FrameRateDen int
+}
+
+//FrameRate gets the frames per second
+func (vi VideoInfo) FrameRate() float64 {
+	return float64(vi.FrameRateNum) / float64(vi.FrameRateDen)
+}
+
+//FrameDuration gets the seconds each frame stays up at the native frame rate
+func (vi VideoInfo) FrameDuration() float64 {
+	return float64(vi.FrameRateDen) / float64(vi.FrameRateNum)
+}
+
+//VideoFrame is one decoded picture, reused for later frames once it has been uploaded
+type VideoFrame struct {
+	//Packed pixels use only the first plane
+	Planes  [3][]byte
+	Pitches [3]int
+
+	//When the frame is shown and for how long, in seconds from the start of the stream
+	PTS      float64
+	Duration float64
+}
+
+//NewVideoFrame allocates the planes for a frame of the given layout
+func NewVideoFrame(info VideoInfo) *VideoFrame {
+	frame := &VideoFrame{}
+
+	switch info.Format {
+	case videoYUV420:
+		//Chroma planes round up for odd sizes
+		chromaWidth, chromaHeight := (info.Width+1)/2, (info.Height+1)/2
+		frame.Planes[0], frame.Pitches[0] = make([]byte, info.Width*info.Height), info.Width
+		frame.Planes[1], frame.Pitches[1] = make([]byte, chromaWidth*chromaHeight), chromaWidth
+		frame.Planes[2], frame.Pitches[2] = make([]byte, chromaWidth*chromaHeight), chromaWidth
+		break
+	default:
+		frame.Planes[0], frame.Pitches[0] = make([]byte, info.Width*info.Height*4), info.Width*4
+	}
+
+	return frame
+}
+
+//FrameSource decodes frames of a video one after another
+//Sources are only used by the decoding goroutine, so they don't need to be thread safe or touch SDL
+type FrameSource interface {
+	//Info gets the size, layout and frame rate of the frames
+	Info() VideoInfo
+
+	//ReadFrame decodes the next frame into the given buffer, returning io.EOF after the last one
+	//PTS counts from the start of the stream
+	ReadFrame(frame *VideoFrame) error
+
+	//Rewind goes back to the first frame
+	Rewind() error
+
+	//Close releases the source
+	Close() error
+}
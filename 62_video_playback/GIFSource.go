@@ -0,0 +1,140 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"io"
+	"os"
+)
+
+//Delay browsers use for GIF frames without one, in hundredths of a second
+const gifDefaultDelay = 10
+
+//GIFSource plays an animated GIF, compositing each frame over the ones before it
+type GIFSource struct {
+	mGIF *gif.GIF
+
+	//Next frame to composite
+	mIndex int
+
+	//Time the next frame starts at
+	mPTS float64
+
+	//Picture built up so far and a copy to restore frames disposed to the previous one
+	mCanvas   *image.NRGBA
+	mPrevious *image.NRGBA
+
+	mInfo VideoInfo
+}
+
+//NewGIFSource decodes an animated GIF file
+func NewGIFSource(path string) (*GIFSource, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open %v: %v", path, err)
+	}
+	defer file.Close()
+
+	animation, err := gif.DecodeAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode %v: %v", path, err)
+	}
+	if len(animation.Image) == 0 {
+		return nil, fmt.Errorf("%v has no frames", path)
+	}
+
+	//Frame times vary, report the average rate in hundredths of a second
+	total := 0
+	for i := range animation.Image {
+		total += gifDelay(animation, i)
+	}
+
+	width, height := animation.Config.Width, animation.Config.Height
+	bounds := image.Rect(0, 0, width, height)
+	gs := &GIFSource{
+		mGIF:      animation,
+		mCanvas:   image.NewNRGBA(bounds),
+		mPrevious: image.NewNRGBA(bounds),
+		mInfo:     VideoInfo{Width: width, Height: height, Format: videoRGBA, FrameRateNum: len(animation.Image) * 100, FrameRateDen: total},
+	}
+
+	return gs, nil
+}
+
+//Info gets the size, layout and average frame rate of the frames
+func (gs *GIFSource) Info() VideoInfo {
+	return gs.mInfo
+}
+
+//ReadFrame composites the next frame
+func (gs *GIFSource) ReadFrame(frame *VideoFrame) error {
+	if gs.mIndex >= len(gs.mGIF.Image) {
+		return io.EOF
+	}
+
+	//Clear up after the previous frame
+	if gs.mIndex > 0 {
+		last := gs.mGIF.Image[gs.mIndex-1]
+		switch gs.disposal(gs.mIndex - 1) {
+		case gif.DisposalBackground:
+			draw.Draw(gs.mCanvas, last.Bounds(), image.Transparent, image.Point{}, draw.Src)
+			break
+		case gif.DisposalPrevious:
+			copy(gs.mCanvas.Pix, gs.mPrevious.Pix)
+			break
+		}
+	}
+
+	//Remember the canvas if this frame is going to be undone
+	current := gs.mGIF.Image[gs.mIndex]
+	if gs.disposal(gs.mIndex) == gif.DisposalPrevious {
+		copy(gs.mPrevious.Pix, gs.mCanvas.Pix)
+	}
+	draw.Draw(gs.mCanvas, current.Bounds(), current, current.Bounds().Min, draw.Over)
+
+	//Copy the canvas into the frame
+	for y := 0; y < gs.mInfo.Height; y++ {
+		copy(frame.Planes[0][y*frame.Pitches[0]:], gs.mCanvas.Pix[y*gs.mCanvas.Stride:y*gs.mCanvas.Stride+gs.mInfo.Width*4])
+	}
+
+	frame.PTS = gs.mPTS
+	frame.Duration = float64(gifDelay(gs.mGIF, gs.mIndex)) / 100
+	gs.mPTS += frame.Duration
+	gs.mIndex++
+
+	return nil
+}
+
+//Rewind goes back to the first frame and clears the canvas
+func (gs *GIFSource) Rewind() error {
+	gs.mIndex = 0
+	gs.mPTS = 0
+	draw.Draw(gs.mCanvas, gs.mCanvas.Rect, image.Transparent, image.Point{}, draw.Src)
+
+	return nil
+}
+
+//Close releases the source
+func (gs *GIFSource) Close() error {
+	return nil
+}
+
+//disposal gets what happens to a frame once the next one is shown
+func (gs *GIFSource) disposal(index int) byte {
+	if index < len(gs.mGIF.Disposal) {
+		return gs.mGIF.Disposal[index]
+	}
+
+	return 0
+}
+
+//gifDelay gets how long a frame stays up in hundredths of a second
+func gifDelay(animation *gif.GIF, index int) int {
+	if index < len(animation.Delay) && animation.Delay[index] > 0 {
+		return animation.Delay[index]
+	}
+
+	return gifDefaultDelay
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	//Register the decoders image.Decode picks from
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+//ImageSequenceSource plays numbered image files as frames at a fixed frame rate
+type ImageSequenceSource struct {
+	//Frame image files in playback order
+	mPaths []string
+
+	//Next frame to decode
+	mIndex int
+
+	mInfo VideoInfo
+}
+
+//NewImageSequenceSource plays the files matching a glob pattern in name order
+//Every image has to be the size of the first one
+func NewImageSequenceSource(pattern string, frameRateNum, frameRateDen int) (*ImageSequenceSource, error) {
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image sequence pattern %v: %v", pattern, err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no images match %v", pattern)
+	}
+	if frameRateNum <= 0 || frameRateDen <= 0 {
+		return nil, fmt.Errorf("invalid frame rate %d/%d", frameRateNum, frameRateDen)
+	}
+	sort.Strings(paths)
+
+	//Get the frame size from the first image
+	file, err := os.Open(paths[0])
+	if err != nil {
+		return nil, fmt.Errorf("could not open %v: %v", paths[0], err)
+	}
+	defer file.Close()
+
+	config, _, err := image.DecodeConfig(file)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %v: %v", paths[0], err)
+	}
+
+	info := VideoInfo{Width: config.Width, Height: config.Height, Format: videoRGBA, FrameRateNum: frameRateNum, FrameRateDen: frameRateDen}
+	return &ImageSequenceSource{mPaths: paths, mInfo: info}, nil
+}
+
+//Info gets the size, layout and frame rate of the frames
+func (iss *ImageSequenceSource) Info() VideoInfo {
+	return iss.mInfo
+}
+
+//ReadFrame decodes the next image
+func (iss *ImageSequenceSource) ReadFrame(frame *VideoFrame) error {
+	if iss.mIndex >= len(iss.mPaths) {
+		return io.EOF
+	}
+	path := iss.mPaths[iss.mIndex]
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("could not open %v: %v", path, err)
+	}
+	defer file.Close()
+
+	decoded, _, err := image.Decode(file)
+	if err != nil {
+		return fmt.Errorf("could not decode %v: %v", path, err)
+	}
+
+	bounds := decoded.Bounds()
+	if bounds.Dx() != iss.mInfo.Width || bounds.Dy() != iss.mInfo.Height {
+		return fmt.Errorf("%v is %dx%d but the sequence is %dx%d", path, bounds.Dx(), bounds.Dy(), iss.mInfo.Width, iss.mInfo.Height)
+	}
+
+	//NRGBA has the byte order of RGBA32 and keeps alpha straight
+	target := &image.NRGBA{Pix: frame.Planes[0], Stride: frame.Pitches[0], Rect: image.Rect(0, 0, iss.mInfo.Width, iss.mInfo.Height)}
+	draw.Draw(target, target.Rect, decoded, bounds.Min, draw.Src)
+
+	frame.Duration = iss.mInfo.FrameDuration()
+	frame.PTS = float64(iss.mIndex) * frame.Duration
+	iss.mIndex++
+
+	return nil
+}
+
+//Rewind goes back to the first image
+func (iss *ImageSequenceSource) Rewind() error {
+	iss.mIndex = 0
+	return nil
+}
+
+//Close releases the source
+func (iss *ImageSequenceSource) Close() error {
+	return nil
+}
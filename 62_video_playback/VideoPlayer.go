@@ -0,0 +1,334 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"unsafe"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//MediaClock is the time playback follows, in seconds from the start of the stream
+//Video is usually slaved to the audio clock so the two stay in sync
+type MediaClock interface {
+	Time() float64
+}
+
+//TimerClock is a media clock driven by an LTimer, pausing the timer pauses playback
+type TimerClock struct {
+	mTimer *LTimer
+}
+
+//NewTimerClock wraps a timer as a media clock
+func NewTimerClock(timer *LTimer) *TimerClock {
+	return &TimerClock{mTimer: timer}
+}
+
+//Time gets the timer's time in seconds
+func (tc *TimerClock) Time() float64 {
+	return float64(tc.mTimer.GetTicks()) / 1000
+}
+
+//SyncHook is called whenever a frame is shown with its timestamp and the clock time
+//The difference is how far video is ahead of the clock, which an audio mixer can use to resample or skip
+type SyncHook func(framePTS, clockTime float64)
+
+//VideoPlayer decodes a frame source on a goroutine and shows its frames on a streaming texture when they are due
+type VideoPlayer struct {
+	mSource FrameSource
+	mInfo   VideoInfo
+
+	//Texture frames are uploaded to
+	mTexture LTexture
+
+	//Decoded frames waiting to be shown, bounded so decoding never runs too far ahead
+	mQueue chan *VideoFrame
+
+	//Frames already uploaded, handed back to the decoder to be reused
+	mFree chan *VideoFrame
+
+	//Number of frames the queue holds
+	mQueueSize int
+
+	//Tells the decoder to stop, and the decoder reports back when it exits with its error if any
+	//Channels can't be closed here since every lesson has its own close function
+	mStop chan struct{}
+	mDone chan error
+
+	//The decoder has exited and won't queue any more frames
+	mDecoderDone bool
+
+	//Start the stream over when it ends
+	mLooping bool
+
+	//Time playback follows and who to tell when frames are shown
+	mClock    MediaClock
+	mSyncHook SyncHook
+
+	//Frame taken off the queue that isn't due yet
+	mPending *VideoFrame
+
+	//Timestamp of the frame on the texture
+	mPosition float64
+
+	//Playback statistics
+	mShown   int
+	mDropped int
+	mEnded   bool
+}
+
+//NewVideoPlayer initializes a player that buffers up to queueSize decoded frames
+func NewVideoPlayer(source FrameSource, queueSize int) *VideoPlayer {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+
+	return &VideoPlayer{mSource: source, mInfo: source.Info(), mQueueSize: queueSize}
+}
+
+//SetLooping sets whether the stream starts over when it ends
+func (vp *VideoPlayer) SetLooping(looping bool) {
+	vp.mLooping = looping
+}
+
+//SetClock makes playback follow a clock, like the audio clock
+func (vp *VideoPlayer) SetClock(clock MediaClock) {
+	vp.mClock = clock
+}
+
+//SetSyncHook sets the function called whenever a frame is shown
+func (vp *VideoPlayer) SetSyncHook(hook SyncHook) {
+	vp.mSyncHook = hook
+}
+
+//Start creates the texture and starts decoding, call it after setting the clock
+func (vp *VideoPlayer) Start() error {
+	if vp.mClock == nil {
+		return fmt.Errorf("video player has no clock")
+	}
+	if vp.mStop != nil {
+		return fmt.Errorf("video player already started")
+	}
+
+	//Create the streaming texture in the source's layout
+	err := vp.mTexture.CreateBlank(int32(vp.mInfo.Width), int32(vp.mInfo.Height), vp.mInfo.Format.PixelFormat())
+	if err != nil {
+		return fmt.Errorf("could not create video texture: %v", err)
+	}
+	if vp.mInfo.Format == videoRGBA {
+		if err := vp.mTexture.SetBlendMode(sdl.BLENDMODE_BLEND); err != nil {
+			return fmt.Errorf("could not enable video blending: %v", err)
+		}
+	}
+
+	//One frame for each queue slot, one being decoded and one pending
+	vp.mQueue = make(chan *VideoFrame, vp.mQueueSize)
+	vp.mFree = make(chan *VideoFrame, vp.mQueueSize+2)
+	for i := 0; i < vp.mQueueSize+2; i++ {
+		vp.mFree <- NewVideoFrame(vp.mInfo)
+	}
+
+	vp.mStop = make(chan struct{}, 1)
+	vp.mDone = make(chan error, 1)
+	go vp.decode()
+
+	return nil
+}
+
+//Update uploads the latest frame that is due by the clock and drops the ones it skipped
+//Call it once per rendered frame, it gets whether the texture changed
+func (vp *VideoPlayer) Update() (bool, error) {
+	if vp.mEnded {
+		return false, nil
+	}
+
+	now := vp.mClock.Time()
+
+	//Take every frame that is due, only the last one is worth showing
+	var show *VideoFrame
+	for {
+		if vp.mPending == nil {
+			select {
+			case vp.mPending = <-vp.mQueue:
+				break
+			default:
+				//The decoder is behind or done, keep showing the last frame
+				break
+			}
+		}
+
+		//The stream ends once the decoder is done and everything it queued has been taken
+		if vp.mPending == nil && !vp.mDecoderDone {
+			select {
+			case err := <-vp.mDone:
+				vp.mDecoderDone = true
+				if err != nil {
+					vp.mEnded = true
+					return false, fmt.Errorf("could not decode video: %v", err)
+				}
+				break
+			default:
+				break
+			}
+		}
+		if vp.mPending == nil && vp.mDecoderDone && len(vp.mQueue) == 0 {
+			vp.mEnded = true
+		}
+
+		if vp.mPending == nil || vp.mPending.PTS > now {
+			break
+		}
+
+		if show != nil {
+			vp.mDropped++
+			vp.mFree <- show
+		}
+		show, vp.mPending = vp.mPending, nil
+	}
+
+	if show == nil {
+		return false, nil
+	}
+
+	//Upload the frame and give the buffer back
+	err := vp.upload(show)
+	vp.mPosition = show.PTS
+	vp.mShown++
+	vp.mFree <- show
+	if err != nil {
+		return false, err
+	}
+
+	if vp.mSyncHook != nil {
+		vp.mSyncHook(vp.mPosition, now)
+	}
+
+	return true, nil
+}
+
+//Render shows the current frame
+func (vp *VideoPlayer) Render(x, y int32) error {
+	if vp.mShown == 0 {
+		return nil
+	}
+
+	return vp.mTexture.Render(x, y, nil, 0, nil, sdl.FLIP_NONE)
+}
+
+//Info gets the size, layout and frame rate of the video
+func (vp *VideoPlayer) Info() VideoInfo {
+	return vp.mInfo
+}
+
+//Position gets the timestamp of the frame being shown
+func (vp *VideoPlayer) Position() float64 {
+	return vp.mPosition
+}
+
+//Queued gets the number of decoded frames waiting
+func (vp *VideoPlayer) Queued() int {
+	return len(vp.mQueue)
+}
+
+//Shown gets the number of frames uploaded so far
+func (vp *VideoPlayer) Shown() int {
+	return vp.mShown
+}
+
+//Dropped gets the number of frames skipped because playback fell behind the clock
+func (vp *VideoPlayer) Dropped() int {
+	return vp.mDropped
+}
+
+//Ended checks if the stream has played out
+func (vp *VideoPlayer) Ended() bool {
+	return vp.mEnded
+}
+
+//Free stops decoding and deallocates the texture and source
+func (vp *VideoPlayer) Free() error {
+	if vp.mStop != nil {
+		//Stop the decoder and wait for it to let go of the source
+		vp.mStop <- struct{}{}
+		if !vp.mDecoderDone {
+			<-vp.mDone
+			vp.mDecoderDone = true
+		}
+		vp.mStop = nil
+	}
+
+	if err := vp.mTexture.Free(); err != nil {
+		return fmt.Errorf("could not free video texture: %v", err)
+	}
+	if err := vp.mSource.Close(); err != nil {
+		return fmt.Errorf("could not close video source: %v", err)
+	}
+
+	return nil
+}
+
+//decode runs on its own goroutine filling the queue until the stream ends or the player stops
+func (vp *VideoPlayer) decode() {
+	vp.mDone <- vp.decodeFrames()
+}
+
+//decodeFrames decodes into free frames and queues them, returning nil at the end of the stream
+func (vp *VideoPlayer) decodeFrames() error {
+	//Looped streams keep counting time from where the last pass ended
+	var offset, end float64
+
+	for {
+		//Wait for a buffer to decode into
+		var frame *VideoFrame
+		select {
+		case frame = <-vp.mFree:
+			break
+		case <-vp.mStop:
+			return nil
+		}
+
+		err := vp.mSource.ReadFrame(frame)
+		if err == io.EOF && vp.mLooping && end > offset {
+			if err = vp.mSource.Rewind(); err == nil {
+				offset = end
+				err = vp.mSource.ReadFrame(frame)
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		frame.PTS += offset
+		end = frame.PTS + frame.Duration
+
+		//Wait for room in the queue
+		select {
+		case vp.mQueue <- frame:
+			break
+		case <-vp.mStop:
+			return nil
+		}
+	}
+}
+
+//upload copies a frame into the texture
+func (vp *VideoPlayer) upload(frame *VideoFrame) error {
+	texture := vp.mTexture.mTexture
+
+	if vp.mInfo.Format == videoYUV420 {
+		err := texture.UpdateYUV(nil, frame.Planes[0], frame.Pitches[0], frame.Planes[1], frame.Pitches[1], frame.Planes[2], frame.Pitches[2])
+		if err != nil {
+			return fmt.Errorf("could not update YUV texture: %v", err)
+		}
+		return nil
+	}
+
+	if err := texture.Update(nil, unsafe.Pointer(&frame.Planes[0][0]), frame.Pitches[0]); err != nil {
+		return fmt.Errorf("could not update texture: %v", err)
+	}
+
+	return nil
+}
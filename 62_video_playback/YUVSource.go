@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+//Longest Y4M header or frame header line accepted
+const y4mMaxLine = 1024
+
+//YUVSource plays planar 4:2:0 video from a Y4M file or a headerless raw YUV file
+type YUVSource struct {
+	mFile   *os.File
+	mReader *bufio.Reader
+
+	//Where the first frame starts
+	mDataOffset int64
+
+	//Y4M puts a FRAME line before every frame, raw files are just planes
+	mY4M bool
+
+	//Next frame number
+	mIndex int
+
+	mInfo VideoInfo
+}
+
+//NewY4MSource opens a YUV4MPEG2 file, reading the size and frame rate from its header
+func NewY4MSource(path string) (*YUVSource, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open %v: %v", path, err)
+	}
+
+	ys := &YUVSource{mFile: file, mReader: bufio.NewReader(file), mY4M: true}
+
+	header, err := ys.readLine()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("could not read Y4M header of %v: %v", path, err)
+	}
+	ys.mDataOffset = int64(len(header) + 1)
+
+	if ys.mInfo, err = parseY4MHeader(header); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("error loading %v: %v", path, err)
+	}
+
+	return ys, nil
+}
+
+//NewRawYUVSource opens a raw I420 file, which doesn't store its size or frame rate
+func NewRawYUVSource(path string, width, height, frameRateNum, frameRateDen int) (*YUVSource, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("invalid raw video size %dx%d", width, height)
+	}
+	if frameRateNum <= 0 || frameRateDen <= 0 {
+		return nil, fmt.Errorf("invalid frame rate %d/%d", frameRateNum, frameRateDen)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open %v: %v", path, err)
+	}
+
+	info := VideoInfo{Width: width, Height: height, Format: videoYUV420, FrameRateNum: frameRateNum, FrameRateDen: frameRateDen}
+	return &YUVSource{mFile: file, mReader: bufio.NewReader(file), mInfo: info}, nil
+}
+
+//Info gets the size, layout and frame rate of the frames
+func (ys *YUVSource) Info() VideoInfo {
+	return ys.mInfo
+}
+
+//ReadFrame reads the planes of the next frame
+func (ys *YUVSource) ReadFrame(frame *VideoFrame) error {
+	if ys.mY4M {
+		line, err := ys.readLine()
+		if err == io.EOF {
+			return io.EOF
+		}
+		if err != nil {
+			return fmt.Errorf("could not read frame %d header: %v", ys.mIndex, err)
+		}
+		if !strings.HasPrefix(line, "FRAME") {
+			return fmt.Errorf("expected FRAME header at frame %d, got %q", ys.mIndex, line)
+		}
+	}
+
+	for plane := range frame.Planes {
+		_, err := io.ReadFull(ys.mReader, frame.Planes[plane])
+
+		//Running out before the first plane is the end of the file, anywhere else it was cut short
+		if err == io.EOF && plane == 0 && !ys.mY4M {
+			return io.EOF
+		}
+		if err != nil {
+			return fmt.Errorf("could not read frame %d: %v", ys.mIndex, err)
+		}
+	}
+
+	frame.Duration = ys.mInfo.FrameDuration()
+	frame.PTS = float64(ys.mIndex) * frame.Duration
+	ys.mIndex++
+
+	return nil
+}
+
+//Rewind seeks back to the first frame
+func (ys *YUVSource) Rewind() error {
+	if _, err := ys.mFile.Seek(ys.mDataOffset, io.SeekStart); err != nil {
+		return fmt.Errorf("could not rewind video: %v", err)
+	}
+	ys.mReader.Reset(ys.mFile)
+	ys.mIndex = 0
+
+	return nil
+}
+
+//Close closes the file
+func (ys *YUVSource) Close() error {
+	return ys.mFile.Close()
+}
+
+//readLine reads a newline terminated header line
+func (ys *YUVSource) readLine() (string, error) {
+	var line []byte
+	for {
+		b, err := ys.mReader.ReadByte()
+		if err == io.EOF && len(line) > 0 {
+			return "", io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return "", err
+		}
+		if b == '\n' {
+			return string(line), nil
+		}
+
+		line = append(line, b)
+		if len(line) > y4mMaxLine {
+			return "", fmt.Errorf("header line longer than %d bytes", y4mMaxLine)
+		}
+	}
+}
+
+//parseY4MHeader reads the stream parameters, only 4:2:0 progressive video is supported
+func parseY4MHeader(header string) (VideoInfo, error) {
+	fields := strings.Fields(header)
+	if len(fields) == 0 || fields[0] != "YUV4MPEG2" {
+		return VideoInfo{}, fmt.Errorf("not a YUV4MPEG2 file")
+	}
+
+	//Y4M defaults to 4:2:0 when there is no C parameter
+	info := VideoInfo{Format: videoYUV420}
+
+	for _, field := range fields[1:] {
+		value := field[1:]
+
+		switch field[0] {
+		case 'W':
+			width, err := strconv.Atoi(value)
+			if err != nil || width <= 0 {
+				return VideoInfo{}, fmt.Errorf("invalid width %q", value)
+			}
+			info.Width = width
+			break
+		case 'H':
+			height, err := strconv.Atoi(value)
+			if err != nil || height <= 0 {
+				return VideoInfo{}, fmt.Errorf("invalid height %q", value)
+			}
+			info.Height = height
+			break
+		case 'F':
+			parts := strings.Split(value, ":")
+			if len(parts) != 2 {
+				return VideoInfo{}, fmt.Errorf("invalid frame rate %q", value)
+			}
+			num, errNum := strconv.Atoi(parts[0])
+			den, errDen := strconv.Atoi(parts[1])
+			if errNum != nil || errDen != nil || num <= 0 || den <= 0 {
+				return VideoInfo{}, fmt.Errorf("invalid frame rate %q", value)
+			}
+			info.FrameRateNum, info.FrameRateDen = num, den
+			break
+		case 'C':
+			//Chroma siting variants only move samples by a fraction of a pixel, high bit depths like 420p10 aren't 8 bit
+			switch value {
+			case "420", "420jpeg", "420mpeg2", "420paldv":
+				break
+			default:
+				return VideoInfo{}, fmt.Errorf("unsupported colorspace %q, only 8 bit 4:2:0 is supported", value)
+			}
+			break
+		case 'I':
+			if value != "p" && value != "?" {
+				return VideoInfo{}, fmt.Errorf("unsupported interlacing %q, only progressive video is supported", value)
+			}
+			break
+		}
+	}
+
+	if info.Width == 0 || info.Height == 0 {
+		return VideoInfo{}, fmt.Errorf("header has no frame size")
+	}
+	if info.FrameRateNum == 0 {
+		return VideoInfo{}, fmt.Errorf("header has no frame rate")
+	}
+
+	return info, nil
+}
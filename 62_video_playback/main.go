@@ -0,0 +1,298 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/veandco/go-sdl2/img"
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/veandco/go-sdl2/ttf"
+)
+
+const (
+	//Screen dimension constants
+	screenWitdh  = 640
+	screenHeight = 480
+)
+
+var (
+	//The window we'll be rendering to
+	gWindow *sdl.Window
+
+	//The window renderer
+	gRenderer *sdl.Renderer
+
+	//Globally used font
+	gFont *ttf.Font
+
+	//Video being played and the clock it follows
+	gVideoPlayer *VideoPlayer
+	gVideoTimer  LTimer
+
+	//How far the last frame shown was from the clock, in seconds
+	gDrift float64
+
+	//Playback options
+	videoPath = flag.String("video", "foo_walk_*.png", "video to play: an image sequence pattern, a .gif, a .y4m or a raw I420 .yuv")
+	frameRate = flag.String("fps", "15", "frame rate of image sequences and raw video, like 30 or 30000/1001")
+	videoSize = flag.String("size", "", "frame size of raw video, like 320x240")
+	queueSize = flag.Int("queue", 8, "number of decoded frames buffered ahead of playback")
+	loopVideo = flag.Bool("loop", true, "start the video over when it ends")
+)
+
+func main() {
+	flag.Parse()
+
+	//Start up SDL and create window
+	if err := initSDl(); err != nil {
+		log.Fatalf("Could not init SDL: %v\n", err)
+	}
+
+	//Load media
+	if err := loadMedia(); err != nil {
+		log.Fatalf("Could not load media: %v\n", err)
+	}
+
+	//Main loop flag
+	var quit bool
+
+	//Caption shown in the window title
+	var caption string
+
+	//Event handler
+	var e sdl.Event
+
+	//Start playback
+	gVideoTimer.Start()
+
+	//While application is running
+	for !quit {
+		//Handle events on queue
+		for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+			//User requests quit
+			if e.GetType() == sdl.QUIT {
+				quit = true
+			}
+
+			//Pause or resume the clock the video follows
+			if e.GetType() == sdl.KEYDOWN && (e.(*sdl.KeyboardEvent)).Repeat == 0 &&
+				(e.(*sdl.KeyboardEvent)).Keysym.Sym == sdl.K_SPACE {
+				if gVideoTimer.IsPaused() {
+					gVideoTimer.Unpause()
+				} else {
+					gVideoTimer.Pause()
+				}
+			}
+		}
+
+		//Upload the frame that is due
+		if _, err := gVideoPlayer.Update(); err != nil {
+			log.Fatalf("%v\n", err)
+		}
+
+		//Show playback state
+		info := gVideoPlayer.Info()
+		newCaption := fmt.Sprintf("SDL Tutorial - %v %dx%d at %.2f fps: %.2fs, %d queued, %d dropped, drift %.0fms",
+			info.Format, info.Width, info.Height, info.FrameRate(), gVideoPlayer.Position(),
+			gVideoPlayer.Queued(), gVideoPlayer.Dropped(), gDrift*1000)
+		if gVideoTimer.IsPaused() {
+			newCaption += ", paused"
+		} else if gVideoPlayer.Ended() {
+			newCaption += ", ended"
+		}
+		if newCaption != caption {
+			caption = newCaption
+			gWindow.SetTitle(caption)
+		}
+
+		//Clear screen
+		err := gRenderer.SetDrawColor(255, 255, 255, 255)
+		if err != nil {
+			log.Fatalf("could not set draw color for renderer: %v", err)
+		}
+		err = gRenderer.Clear()
+		if err != nil {
+			log.Fatalf("could not clear renderer: %v", err)
+		}
+
+		//Render frame
+		err = gVideoPlayer.Render((screenWitdh-int32(info.Width))/2, (screenHeight-int32(info.Height))/2)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		//Update screen
+		gRenderer.Present()
+	}
+
+	//Free resources and close SDL
+	if err := close(); err != nil {
+		log.Fatalf("Could not close SDL! SDL Error: %v\n", err)
+	}
+}
+
+func initSDl() error {
+	//Local error declaration
+	var err error
+
+	//Initialize SDL
+	if err := sdl.Init(sdl.INIT_VIDEO); err != nil {
+		return fmt.Errorf("SDL could not initialize! SDL_ERROR: %v", err)
+	}
+
+	//Set texture filtering to linear
+	if !sdl.SetHint(sdl.HINT_RENDER_SCALE_QUALITY, "1") {
+		fmt.Printf("Warning: Linear texture filtering not enabled!")
+	}
+
+	//Create Window
+	gWindow, err = sdl.CreateWindow("SDL Tutorial", sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED,
+		screenWitdh, screenHeight, sdl.WINDOW_SHOWN)
+	if err != nil {
+		return fmt.Errorf("Window could not be created! SDL_Error: %v", err)
+	}
+
+	//Create vsynced renderer for window
+	if gRenderer, err = sdl.CreateRenderer(gWindow, -1, sdl.RENDERER_ACCELERATED|sdl.RENDERER_PRESENTVSYNC); err != nil {
+		return fmt.Errorf("Renderer could not be created! SDL Error: %v", err)
+	}
+
+	//Initialize renderer color
+	gRenderer.SetDrawColor(255, 255, 255, 255)
+
+	//Initialize PNG loading
+	imgFlags := img.INIT_PNG
+	if (img.Init(imgFlags) & imgFlags) == 0 {
+		return fmt.Errorf("SDL_image could not initialize! SDL_image Error: %v", img.GetError())
+	}
+
+	//Initialize SDL_ttf
+	if err := ttf.Init(); err != nil {
+		return fmt.Errorf("SDL_ttf could not initialize! SDL_ttf Error: %v", err)
+	}
+
+	return nil
+}
+
+func loadMedia() error {
+	//Open video
+	source, err := openVideo(*videoPath)
+	if err != nil {
+		return fmt.Errorf("unable to open video: %v", err)
+	}
+
+	//Play it against the timer
+	gVideoPlayer = NewVideoPlayer(source, *queueSize)
+	gVideoPlayer.SetLooping(*loopVideo)
+	gVideoPlayer.SetClock(NewTimerClock(&gVideoTimer))
+	gVideoPlayer.SetSyncHook(func(framePTS, clockTime float64) {
+		gDrift = framePTS - clockTime
+	})
+	if err := gVideoPlayer.Start(); err != nil {
+		return fmt.Errorf("unable to start video: %v", err)
+	}
+
+	return nil
+}
+
+func close() error {
+	//Stop playback
+	if err := gVideoPlayer.Free(); err != nil {
+		return fmt.Errorf("could not free video player: %v", err)
+	}
+
+	//Destroy window
+	if err := gRenderer.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy global renderer: %v", err)
+	}
+	if err := gWindow.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy window: %v", err)
+	}
+	gWindow = nil
+	gRenderer = nil
+
+	//Quit SDL Subsystems
+	ttf.Quit()
+	img.Quit()
+	sdl.Quit()
+
+	return nil
+}
+
+//openVideo picks a frame source by file extension
+func openVideo(path string) (FrameSource, error) {
+	var source FrameSource
+	var err error
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".gif":
+		source, err = NewGIFSource(path)
+		break
+
+	case ".y4m":
+		source, err = NewY4MSource(path)
+		break
+
+	case ".yuv":
+		//Raw video doesn't know its own size or frame rate
+		num, den, rateErr := parseFrameRate(*frameRate)
+		if rateErr != nil {
+			return nil, rateErr
+		}
+		width, height, sizeErr := parseSize(*videoSize)
+		if sizeErr != nil {
+			return nil, sizeErr
+		}
+		source, err = NewRawYUVSource(path, width, height, num, den)
+		break
+
+	default:
+		//Anything else is a pattern of image files
+		num, den, rateErr := parseFrameRate(*frameRate)
+		if rateErr != nil {
+			return nil, rateErr
+		}
+		source, err = NewImageSequenceSource(path, num, den)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return source, nil
+}
+
+//parseFrameRate reads a frame rate as a whole number or a fraction
+func parseFrameRate(rate string) (int, int, error) {
+	parts := strings.SplitN(rate, "/", 2)
+	if len(parts) == 1 {
+		parts = append(parts, "1")
+	}
+
+	num, errNum := strconv.Atoi(parts[0])
+	den, errDen := strconv.Atoi(parts[1])
+	if errNum != nil || errDen != nil || num <= 0 || den <= 0 {
+		return 0, 0, fmt.Errorf("invalid frame rate %q", rate)
+	}
+
+	return num, den, nil
+}
+
+//parseSize reads a frame size like 320x240
+func parseSize(size string) (int, int, error) {
+	parts := strings.SplitN(size, "x", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid frame size %q, raw video needs -size like 320x240", size)
+	}
+
+	width, errWidth := strconv.Atoi(parts[0])
+	height, errHeight := strconv.Atoi(parts[1])
+	if errWidth != nil || errHeight != nil || width <= 0 || height <= 0 {
+		return 0, 0, fmt.Errorf("invalid frame size %q, raw video needs -size like 320x240", size)
+	}
+
+	return width, height, nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"image/color"
+	"math/rand"
+)
+
+//Most columns the wind can move the flames each step
+const maxFireWind = 4
+
+//FireGenerator spreads heat up from a burning bottom row, cooling and drifting it as it rises
+type FireGenerator struct {
+	//Most heat a cell loses each step
+	mCooling int
+
+	//Columns the flames lean each step, negative to the left
+	mWind int
+
+	mPalette *Palette
+
+	//Whether the bottom row is burning
+	mBurning bool
+
+	//Heat of every cell from 0 to 255
+	mHeat []uint8
+
+	mWidth  int
+	mHeight int
+
+	//Flicker and unsimulated time
+	mRandom      *rand.Rand
+	mAccumulator float64
+}
+
+//NewFireGenerator creates a fire with the given cooling per step and wind
+func NewFireGenerator(cooling, wind int, palette *Palette) *FireGenerator {
+	//Heat can't be lost faster than from full to nothing, or gained
+	if cooling < 0 {
+		cooling = 0
+	} else if cooling > 255 {
+		cooling = 255
+	}
+
+	//Flames blown further than this would tear apart
+	if wind < -maxFireWind {
+		wind = -maxFireWind
+	} else if wind > maxFireWind {
+		wind = maxFireWind
+	}
+
+	return &FireGenerator{mCooling: cooling, mWind: wind, mPalette: palette}
+}
+
+//Name gets the generator name
+func (g *FireGenerator) Name() string {
+	return "fire"
+}
+
+//Reset puts the fire out and lights the bottom row
+func (g *FireGenerator) Reset(seed int64, width, height int) {
+	g.mRandom = rand.New(rand.NewSource(seed))
+	g.mHeat = make([]uint8, width*height)
+	g.mWidth, g.mHeight = width, height
+	g.mBurning = true
+	g.mAccumulator = 0
+
+	for x := 0; x < width; x++ {
+		g.mHeat[(height-1)*width+x] = 255
+	}
+}
+
+//Update spreads the heat in fixed steps
+func (g *FireGenerator) Update(seconds float64) {
+	g.mAccumulator += seconds
+	for g.mAccumulator >= generatorStep {
+		g.step()
+		g.mAccumulator -= generatorStep
+	}
+}
+
+//Render colors every cell by its heat
+func (g *FireGenerator) Render(pixels *PixelAccessor) {
+	fillPixels(pixels, func(x, y int) color.RGBA {
+		return g.mPalette[g.mHeat[y*g.mWidth+x]]
+	})
+}
+
+//Poke puts the fire out or lights it again wherever it's clicked
+func (g *FireGenerator) Poke(x, y int) {
+	g.mBurning = !g.mBurning
+
+	var heat uint8
+	if g.mBurning {
+		heat = 255
+	}
+	for i := 0; i < g.mWidth; i++ {
+		g.mHeat[(g.mHeight-1)*g.mWidth+i] = heat
+	}
+}
+
+//step moves every cell's heat up a row
+func (g *FireGenerator) step() {
+	for y := 1; y < g.mHeight; y++ {
+		for x := 0; x < g.mWidth; x++ {
+			//Drift sideways at random plus the wind
+			spread := g.mRandom.Intn(3) - 1
+			//Wrap around either edge, even on textures narrower than the drift
+			target := (x + spread + g.mWind) % g.mWidth
+			if target < 0 {
+				target += g.mWidth
+			}
+
+			heat := int(g.mHeat[y*g.mWidth+x]) - g.mRandom.Intn(g.mCooling+1)
+			if heat < 0 {
+				heat = 0
+			}
+			g.mHeat[(y-1)*g.mWidth+target] = uint8(heat)
+		}
+	}
+}
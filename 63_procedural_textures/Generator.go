@@ -0,0 +1,98 @@
+package main
+
+import (
+	"image/color"
+	"math"
+)
+
+//Generator draws an animated procedural texture
+//Output only depends on the seed and the time steps given to Update, so a seed always plays back the same
+type Generator interface {
+	//Name gets the name shown to the user
+	Name() string
+
+	//Reset seeds the generator and sizes its state for the texture
+	Reset(seed int64, width, height int)
+
+	//Update advances the animation by the given seconds
+	Update(seconds float64)
+
+	//Render writes the current image into locked texture pixels
+	Render(pixels *PixelAccessor)
+}
+
+//Fixed time step of simulated generators, so results don't depend on the frame rate
+const generatorStep = 1.0 / 60
+
+//Palette maps a value from 0 to 255 to a color
+type Palette [256]color.RGBA
+
+//NewGradientPalette spreads colors evenly over the palette and blends between them
+func NewGradientPalette(stops ...color.RGBA) *Palette {
+	palette := &Palette{}
+	if len(stops) == 0 {
+		return palette
+	}
+	if len(stops) == 1 {
+		for i := range palette {
+			palette[i] = stops[0]
+		}
+		return palette
+	}
+
+	for i := range palette {
+		//Find the stops on either side
+		position := float64(i) / 255 * float64(len(stops)-1)
+		low := int(position)
+		if low >= len(stops)-1 {
+			low = len(stops) - 2
+		}
+		t := position - float64(low)
+
+		a, b := stops[low], stops[low+1]
+		palette[i] = color.RGBA{
+			R: lerpByte(a.R, b.R, t),
+			G: lerpByte(a.G, b.G, t),
+			B: lerpByte(a.B, b.B, t),
+			A: lerpByte(a.A, b.A, t),
+		}
+	}
+
+	return palette
+}
+
+//At gets the color for a value from 0 to 1, NaN counts as 0
+func (p *Palette) At(value float64) color.RGBA {
+	if value <= 0 || math.IsNaN(value) {
+		return p[0]
+	}
+	if value >= 1 {
+		return p[255]
+	}
+
+	return p[int(value*255+0.5)]
+}
+
+//lerpByte blends two channel values
+func lerpByte(a, b uint8, t float64) uint8 {
+	return uint8(float64(a) + (float64(b)-float64(a))*t + 0.5)
+}
+
+//Interactive is a generator that reacts to clicks
+type Interactive interface {
+	//Poke disturbs the generator at a texture position
+	Poke(x, y int)
+}
+
+//fillPixels writes a color for every pixel a row at a time
+func fillPixels(pixels *PixelAccessor, shade func(x, y int) color.RGBA) {
+	bounds := pixels.Bounds()
+	row := make([]color.RGBA, bounds.Dx())
+
+	for y := 0; y < bounds.Dy(); y++ {
+		for x := range row {
+			row[x] = shade(x, y)
+		}
+		pixels.WriteRow(y, row)
+	}
+}
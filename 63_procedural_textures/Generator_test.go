@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"hash/crc32"
+	"image/color"
+	"math"
+	"testing"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//Size of the test textures
+const (
+	testTextureWidth  = 48
+	testTextureHeight = 32
+)
+
+//newTestGenerators creates one of every generator, with the same settings as the lesson
+func newTestGenerators() []Generator {
+	gray := NewGradientPalette(color.RGBA{R: 0, G: 0, B: 0, A: 255}, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+
+	return []Generator{
+		NewNoiseGenerator(noisePerlin, 64, 4, 0.5, 0.3, gray),
+		NewNoiseGenerator(noiseSimplex, 48, 3, 0.5, 0.4, gray),
+		NewPlasmaGenerator(24, 1.5, 0.1, gray),
+		NewFireGenerator(6, 0, gray),
+		NewWaterGenerator(0.97, 3, 3, gray),
+		NewLifeGenerator(0.3, 20, gray),
+	}
+}
+
+//renderGenerator seeds a generator, runs it through the time steps and renders it into plain memory
+func renderGenerator(t *testing.T, generator Generator, seed int64, steps []float64) []byte {
+	t.Helper()
+
+	pixels := make([]byte, testTextureWidth*testTextureHeight*4)
+	accessor, err := NewPixelAccessor(pixels, testTextureWidth*4, testTextureWidth, testTextureHeight, uint32(sdl.PIXELFORMAT_RGBA32))
+	if err != nil {
+		t.Fatalf("could not create pixel accessor: %v", err)
+	}
+
+	generator.Reset(seed, testTextureWidth, testTextureHeight)
+	for _, seconds := range steps {
+		generator.Update(seconds)
+	}
+	generator.Render(accessor)
+
+	return pixels
+}
+
+func TestGeneratorsAreDeterministic(t *testing.T) {
+	//Uneven frame times, so leftover time between fixed steps matters too
+	steps := []float64{0.016, 0.033, 0.001, 0.25, 0.017, 0.5}
+
+	for i, generator := range newTestGenerators() {
+		t.Run(fmt.Sprintf("%d %v", i+1, generator.Name()), func(t *testing.T) {
+			first := renderGenerator(t, generator, 42, steps)
+			second := renderGenerator(t, generator, 42, steps)
+			if !bytes.Equal(first, second) {
+				t.Errorf("same seed rendered different pixels")
+			}
+
+			//A fresh generator plays back the same as a reused one
+			fresh := renderGenerator(t, newTestGenerators()[i], 42, steps)
+			if !bytes.Equal(first, fresh) {
+				t.Errorf("fresh generator rendered different pixels from a reset one")
+			}
+
+			//Another seed gives another texture
+			other := renderGenerator(t, generator, 43, steps)
+			if bytes.Equal(first, other) {
+				t.Errorf("seeds 42 and 43 rendered the same pixels")
+			}
+		})
+	}
+}
+
+func TestGeneratorChecksums(t *testing.T) {
+	steps := []float64{0.016, 0.033, 0.001, 0.25, 0.017, 0.5}
+
+	//Pinned so a change to any generator's output shows up
+	tests := []struct {
+		mName     string
+		mChecksum uint32
+	}{
+		{"Perlin noise", 0x2e211722},
+		{"simplex noise", 0xe582bfae},
+		{"plasma", 0x74be046b},
+		{"fire", 0x2af042fa},
+		{"water ripples", 0xcaa21f88},
+		{"game of life", 0xb3d75bc7},
+	}
+
+	for i, generator := range newTestGenerators() {
+		test := tests[i]
+		if generator.Name() != test.mName {
+			t.Fatalf("generator %d is %v, want %v", i+1, generator.Name(), test.mName)
+		}
+
+		if checksum := crc32.ChecksumIEEE(renderGenerator(t, generator, 42, steps)); checksum != test.mChecksum {
+			t.Errorf("%v: checksum %#08x, want %#08x", test.mName, checksum, test.mChecksum)
+		}
+	}
+}
+
+func TestGeneratorBadSettings(t *testing.T) {
+	gray := NewGradientPalette(color.RGBA{A: 255}, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+
+	//NaN gets the first color
+	if got := gray.At(math.NaN()); got != gray[0] {
+		t.Errorf("NaN colored %v, want %v", got, gray[0])
+	}
+
+	//No octaves is clamped to one instead of averaging nothing
+	noOctaves := renderGenerator(t, NewNoiseGenerator(noisePerlin, 64, 0, 0.5, 0.3, gray), 42, nil)
+	oneOctave := renderGenerator(t, NewNoiseGenerator(noisePerlin, 64, 1, 0.5, 0.3, gray), 42, nil)
+	if !bytes.Equal(noOctaves, oneOctave) {
+		t.Error("noise with no octaves differs from one octave")
+	}
+
+	//Negative cooling and wind stronger than the texture is wide don't panic
+	for _, wind := range []int{-testTextureWidth * 2, testTextureWidth * 2} {
+		renderGenerator(t, NewFireGenerator(-5, wind, gray), 42, []float64{0.5})
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"image/color"
+	"math/rand"
+)
+
+//Glider pattern stamped by Poke
+var lifeGlider = [][2]int{{1, 0}, {2, 1}, {0, 2}, {1, 2}, {2, 2}}
+
+//LifeGenerator runs Conway's Game of Life on a wrapping grid, dead cells fade out leaving trails
+type LifeGenerator struct {
+	//Fraction of cells alive at the start
+	mDensity float64
+
+	//Generations per second
+	mRate float64
+
+	mPalette *Palette
+
+	//Cells this generation and scratch space for the next
+	mCells []bool
+	mNext  []bool
+
+	//How recently every cell was alive, 1 while alive
+	mTrail []float64
+
+	mWidth  int
+	mHeight int
+
+	//Unsimulated time
+	mAccumulator float64
+
+	//Number of generations so far
+	mGeneration int
+}
+
+//NewLifeGenerator creates a Game of Life with the given starting density and speed
+func NewLifeGenerator(density, rate float64, palette *Palette) *LifeGenerator {
+	return &LifeGenerator{mDensity: density, mRate: rate, mPalette: palette}
+}
+
+//Name gets the generator name
+func (g *LifeGenerator) Name() string {
+	return "game of life"
+}
+
+//Reset fills the grid at random
+func (g *LifeGenerator) Reset(seed int64, width, height int) {
+	rng := rand.New(rand.NewSource(seed))
+
+	g.mCells = make([]bool, width*height)
+	g.mNext = make([]bool, width*height)
+	g.mTrail = make([]float64, width*height)
+	g.mWidth, g.mHeight = width, height
+	g.mAccumulator = 0
+	g.mGeneration = 0
+
+	for i := range g.mCells {
+		if rng.Float64() < g.mDensity {
+			g.mCells[i] = true
+			g.mTrail[i] = 1
+		}
+	}
+}
+
+//Update runs generations at the set rate
+func (g *LifeGenerator) Update(seconds float64) {
+	if g.mRate <= 0 {
+		return
+	}
+
+	g.mAccumulator += seconds
+	for g.mAccumulator >= 1/g.mRate {
+		g.step()
+		g.mAccumulator -= 1 / g.mRate
+	}
+}
+
+//Render colors living cells with the top of the palette and trails with the rest
+func (g *LifeGenerator) Render(pixels *PixelAccessor) {
+	fillPixels(pixels, func(x, y int) color.RGBA {
+		return g.mPalette.At(g.mTrail[y*g.mWidth+x])
+	})
+}
+
+//Poke drops a glider at a position
+func (g *LifeGenerator) Poke(x, y int) {
+	for _, cell := range lifeGlider {
+		i := g.wrap(x+cell[0], y+cell[1])
+		g.mCells[i] = true
+		g.mTrail[i] = 1
+	}
+}
+
+//Generation gets the number of generations so far
+func (g *LifeGenerator) Generation() int {
+	return g.mGeneration
+}
+
+//step applies the rules to every cell
+func (g *LifeGenerator) step() {
+	for y := 0; y < g.mHeight; y++ {
+		for x := 0; x < g.mWidth; x++ {
+			//Count living neighbours
+			neighbours := 0
+			for dy := -1; dy <= 1; dy++ {
+				for dx := -1; dx <= 1; dx++ {
+					if (dx != 0 || dy != 0) && g.mCells[g.wrap(x+dx, y+dy)] {
+						neighbours++
+					}
+				}
+			}
+
+			//Survive with 2 or 3, be born with 3
+			i := y*g.mWidth + x
+			alive := neighbours == 3 || (neighbours == 2 && g.mCells[i])
+			g.mNext[i] = alive
+
+			if alive {
+				g.mTrail[i] = 1
+			} else {
+				g.mTrail[i] *= 0.85
+			}
+		}
+	}
+
+	g.mCells, g.mNext = g.mNext, g.mCells
+	g.mGeneration++
+}
+
+//wrap gets the index of a cell, joining opposite edges
+func (g *LifeGenerator) wrap(x, y int) int {
+	x = ((x % g.mWidth) + g.mWidth) % g.mWidth
+	y = ((y % g.mHeight) + g.mHeight) % g.mHeight
+
+	return y*g.mWidth + x
+}
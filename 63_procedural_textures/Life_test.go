@@ -0,0 +1,75 @@
+package main
+
+import (
+	"image/color"
+	"testing"
+)
+
+//newEmptyLife creates a Game of Life with no living cells
+func newEmptyLife(width, height int) *LifeGenerator {
+	g := NewLifeGenerator(0, 1, NewGradientPalette(color.RGBA{A: 255}))
+	g.Reset(1, width, height)
+
+	return g
+}
+
+//setCells brings cells to life
+func setCells(g *LifeGenerator, cells [][2]int) {
+	for _, cell := range cells {
+		g.mCells[g.wrap(cell[0], cell[1])] = true
+	}
+}
+
+//checkCells fails the test unless exactly the given cells are alive
+func checkCells(t *testing.T, g *LifeGenerator, want [][2]int) {
+	t.Helper()
+
+	alive := make(map[int]bool)
+	for _, cell := range want {
+		alive[g.wrap(cell[0], cell[1])] = true
+	}
+
+	for i, cell := range g.mCells {
+		if cell != alive[i] {
+			t.Errorf("generation %d: cell (%d, %d) alive = %v, want %v", g.Generation(), i%g.mWidth, i/g.mWidth, cell, alive[i])
+		}
+	}
+}
+
+func TestLifeBlinker(t *testing.T) {
+	g := newEmptyLife(5, 5)
+	horizontal := [][2]int{{1, 2}, {2, 2}, {3, 2}}
+	vertical := [][2]int{{2, 1}, {2, 2}, {2, 3}}
+	setCells(g, horizontal)
+
+	//Flips between a row and a column every generation
+	g.step()
+	checkCells(t, g, vertical)
+	g.step()
+	checkCells(t, g, horizontal)
+}
+
+func TestLifeGlider(t *testing.T) {
+	g := newEmptyLife(8, 8)
+	g.Poke(0, 0)
+
+	//Every 4 generations the glider moves one cell down and right
+	var want [][2]int
+	for _, cell := range lifeGlider {
+		want = append(want, [2]int{cell[0] + 1, cell[1] + 1})
+	}
+	for i := 0; i < 4; i++ {
+		g.step()
+	}
+	checkCells(t, g, want)
+
+	//It wraps around the edges back to where it started
+	for i := 4; i < 8*4; i++ {
+		g.step()
+	}
+	checkCells(t, g, lifeGlider)
+
+	if g.Generation() != 32 {
+		t.Errorf("generation = %d, want 32", g.Generation())
+	}
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"math/rand"
+)
+
+//NoiseKind is the gradient noise algorithm
+type NoiseKind int
+
+//Noise algorithms
+const (
+	noisePerlin NoiseKind = iota
+	noiseSimplex
+)
+
+//String gets the name of the noise algorithm
+func (k NoiseKind) String() string {
+	switch k {
+	case noisePerlin:
+		return "Perlin"
+	case noiseSimplex:
+		return "simplex"
+	}
+
+	return "unknown"
+}
+
+//Gradient directions of 3D simplex noise, the midpoints of a cube's edges
+var simplexGradients = [12][3]float64{
+	{1, 1, 0}, {-1, 1, 0}, {1, -1, 0}, {-1, -1, 0},
+	{1, 0, 1}, {-1, 0, 1}, {1, 0, -1}, {-1, 0, -1},
+	{0, 1, 1}, {0, -1, 1}, {0, 1, -1}, {0, -1, -1},
+}
+
+//Noise is seeded 3D gradient noise
+type Noise struct {
+	//Shuffled 0 to 255 twice over so lookups never wrap
+	mPermutation [512]int
+}
+
+//NewNoise shuffles the permutation table with a seed
+func NewNoise(seed int64) *Noise {
+	n := &Noise{}
+
+	shuffled := rand.New(rand.NewSource(seed)).Perm(256)
+	for i := range n.mPermutation {
+		n.mPermutation[i] = shuffled[i&255]
+	}
+
+	return n
+}
+
+//Perlin gets improved Perlin noise, roughly from -1 to 1
+func (n *Noise) Perlin(x, y, z float64) float64 {
+	//Unit cube containing the point and the position inside it
+	xi, yi, zi := int(math.Floor(x))&255, int(math.Floor(y))&255, int(math.Floor(z))&255
+	x -= math.Floor(x)
+	y -= math.Floor(y)
+	z -= math.Floor(z)
+	u, v, w := fade(x), fade(y), fade(z)
+
+	//Hash the cube corners
+	p := &n.mPermutation
+	a := p[xi] + yi
+	aa, ab := p[a]+zi, p[a+1]+zi
+	b := p[xi+1] + yi
+	ba, bb := p[b]+zi, p[b+1]+zi
+
+	//Blend the corner gradients
+	return lerp(w,
+		lerp(v,
+			lerp(u, perlinGradient(p[aa], x, y, z), perlinGradient(p[ba], x-1, y, z)),
+			lerp(u, perlinGradient(p[ab], x, y-1, z), perlinGradient(p[bb], x-1, y-1, z))),
+		lerp(v,
+			lerp(u, perlinGradient(p[aa+1], x, y, z-1), perlinGradient(p[ba+1], x-1, y, z-1)),
+			lerp(u, perlinGradient(p[ab+1], x, y-1, z-1), perlinGradient(p[bb+1], x-1, y-1, z-1))))
+}
+
+//Simplex gets 3D simplex noise, roughly from -1 to 1
+//It sums 4 corners of a tetrahedron instead of 8 of a cube and has no grid artifacts along the axes
+func (n *Noise) Simplex(x, y, z float64) float64 {
+	const skew = 1.0 / 3
+	const unskew = 1.0 / 6
+
+	//Skew the space to find the simplex cell
+	s := (x + y + z) * skew
+	i, j, k := math.Floor(x+s), math.Floor(y+s), math.Floor(z+s)
+	t := (i + j + k) * unskew
+	x0, y0, z0 := x-(i-t), y-(j-t), z-(k-t)
+
+	//Find which of the six tetrahedra the point is in
+	var i1, j1, k1, i2, j2, k2 int
+	if x0 >= y0 {
+		if y0 >= z0 {
+			i1, j1, k1, i2, j2, k2 = 1, 0, 0, 1, 1, 0
+		} else if x0 >= z0 {
+			i1, j1, k1, i2, j2, k2 = 1, 0, 0, 1, 0, 1
+		} else {
+			i1, j1, k1, i2, j2, k2 = 0, 0, 1, 1, 0, 1
+		}
+	} else {
+		if y0 < z0 {
+			i1, j1, k1, i2, j2, k2 = 0, 0, 1, 0, 1, 1
+		} else if x0 < z0 {
+			i1, j1, k1, i2, j2, k2 = 0, 1, 0, 0, 1, 1
+		} else {
+			i1, j1, k1, i2, j2, k2 = 0, 1, 0, 1, 1, 0
+		}
+	}
+
+	//Offsets from the other corners
+	x1, y1, z1 := x0-float64(i1)+unskew, y0-float64(j1)+unskew, z0-float64(k1)+unskew
+	x2, y2, z2 := x0-float64(i2)+2*unskew, y0-float64(j2)+2*unskew, z0-float64(k2)+2*unskew
+	x3, y3, z3 := x0-1+3*unskew, y0-1+3*unskew, z0-1+3*unskew
+
+	//Hash the corners
+	p := &n.mPermutation
+	ii, jj, kk := int(i)&255, int(j)&255, int(k)&255
+	g0 := p[ii+p[jj+p[kk]]] % 12
+	g1 := p[ii+i1+p[jj+j1+p[kk+k1]]] % 12
+	g2 := p[ii+i2+p[jj+j2+p[kk+k2]]] % 12
+	g3 := p[ii+1+p[jj+1+p[kk+1]]] % 12
+
+	//Sum the corner contributions and scale to about -1 to 1
+	return 32 * (simplexCorner(g0, x0, y0, z0) + simplexCorner(g1, x1, y1, z1) +
+		simplexCorner(g2, x2, y2, z2) + simplexCorner(g3, x3, y3, z3))
+}
+
+//Fractal adds octaves of noise at doubling frequency and falling amplitude, roughly from -1 to 1
+func (n *Noise) Fractal(kind NoiseKind, x, y, z float64, octaves int, persistence float64) float64 {
+	var sum, total float64
+	amplitude, frequency := 1.0, 1.0
+
+	for i := 0; i < octaves; i++ {
+		if kind == noiseSimplex {
+			sum += n.Simplex(x*frequency, y*frequency, z*frequency) * amplitude
+		} else {
+			sum += n.Perlin(x*frequency, y*frequency, z*frequency) * amplitude
+		}
+		total += amplitude
+		amplitude *= persistence
+		frequency *= 2
+	}
+
+	return sum / total
+}
+
+//NoiseGenerator scrolls fractal noise through time
+type NoiseGenerator struct {
+	mKind NoiseKind
+
+	//Size of a noise feature in pixels
+	mScale float64
+
+	//Detail layers and how much each one counts compared to the last
+	mOctaves     int
+	mPersistence float64
+
+	//Noise units travelled through time per second
+	mSpeed float64
+
+	mPalette *Palette
+
+	mNoise *Noise
+	mTime  float64
+}
+
+//NewNoiseGenerator creates a noise generator with the given feature size in pixels, detail and speed
+func NewNoiseGenerator(kind NoiseKind, scale float64, octaves int, persistence, speed float64, palette *Palette) *NoiseGenerator {
+	//Without an octave there's nothing to average
+	if octaves < 1 {
+		octaves = 1
+	}
+
+	return &NoiseGenerator{mKind: kind, mScale: scale, mOctaves: octaves, mPersistence: persistence, mSpeed: speed, mPalette: palette}
+}
+
+//Name gets the generator name
+func (g *NoiseGenerator) Name() string {
+	return fmt.Sprintf("%v noise", g.mKind)
+}
+
+//Reset reshuffles the noise
+func (g *NoiseGenerator) Reset(seed int64, width, height int) {
+	g.mNoise = NewNoise(seed)
+	g.mTime = 0
+}
+
+//Update moves through the noise
+func (g *NoiseGenerator) Update(seconds float64) {
+	g.mTime += seconds * g.mSpeed
+}
+
+//Render shades every pixel by its noise value
+func (g *NoiseGenerator) Render(pixels *PixelAccessor) {
+	fillPixels(pixels, func(x, y int) color.RGBA {
+		value := g.mNoise.Fractal(g.mKind, float64(x)/g.mScale, float64(y)/g.mScale, g.mTime, g.mOctaves, g.mPersistence)
+		return g.mPalette.At(value*0.5 + 0.5)
+	})
+}
+
+//fade smooths the position inside a cell so the noise has no creases at the edges
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+//lerp blends from a to b
+func lerp(t, a, b float64) float64 {
+	return a + t*(b-a)
+}
+
+//perlinGradient dots the offset with one of 12 gradient directions picked by the hash
+func perlinGradient(hash int, x, y, z float64) float64 {
+	h := hash & 15
+
+	u := y
+	if h < 8 {
+		u = x
+	}
+
+	v := z
+	if h < 4 {
+		v = y
+	} else if h == 12 || h == 14 {
+		v = x
+	}
+
+	if h&1 != 0 {
+		u = -u
+	}
+	if h&2 != 0 {
+		v = -v
+	}
+
+	return u + v
+}
+
+//simplexCorner gets a corner's contribution, falling off to nothing within its radius
+func simplexCorner(gradient int, x, y, z float64) float64 {
+	t := 0.6 - x*x - y*y - z*z
+	if t < 0 {
+		return 0
+	}
+	t *= t
+
+	g := simplexGradients[gradient]
+	return t * t * (g[0]*x + g[1]*y + g[2]*z)
+}
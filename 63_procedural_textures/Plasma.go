@@ -0,0 +1,80 @@
+package main
+
+import (
+	"image/color"
+	"math"
+	"math/rand"
+)
+
+//PlasmaGenerator sums moving sine waves and cycles the result through a palette
+type PlasmaGenerator struct {
+	//Size of a wave in pixels
+	mScale float64
+
+	//Radians the waves move per second
+	mSpeed float64
+
+	//Palette turns per second
+	mCycleSpeed float64
+
+	mPalette *Palette
+
+	//Seeded phase and frequency of each wave
+	mPhases      [4]float64
+	mFrequencies [4]float64
+
+	mWidth  int
+	mHeight int
+	mTime   float64
+}
+
+//NewPlasmaGenerator creates a plasma with the given wave size in pixels and speeds
+func NewPlasmaGenerator(scale, speed, cycleSpeed float64, palette *Palette) *PlasmaGenerator {
+	return &PlasmaGenerator{mScale: scale, mSpeed: speed, mCycleSpeed: cycleSpeed, mPalette: palette}
+}
+
+//Name gets the generator name
+func (g *PlasmaGenerator) Name() string {
+	return "plasma"
+}
+
+//Reset picks new waves
+func (g *PlasmaGenerator) Reset(seed int64, width, height int) {
+	rng := rand.New(rand.NewSource(seed))
+	for i := range g.mPhases {
+		g.mPhases[i] = rng.Float64() * 2 * math.Pi
+		g.mFrequencies[i] = 0.75 + rng.Float64()*0.5
+	}
+
+	g.mWidth, g.mHeight = width, height
+	g.mTime = 0
+}
+
+//Update moves the waves
+func (g *PlasmaGenerator) Update(seconds float64) {
+	g.mTime += seconds
+}
+
+//Render shades every pixel by the sum of the waves
+func (g *PlasmaGenerator) Render(pixels *PixelAccessor) {
+	t := g.mTime * g.mSpeed
+	cycle := g.mTime * g.mCycleSpeed
+
+	//A point circling the texture that the radial wave spreads from
+	centerX := float64(g.mWidth)/2/g.mScale + math.Sin(t*0.3)*2
+	centerY := float64(g.mHeight)/2/g.mScale + math.Cos(t*0.4)*2
+
+	fillPixels(pixels, func(x, y int) color.RGBA {
+		px, py := float64(x)/g.mScale, float64(y)/g.mScale
+		dx, dy := px-centerX, py-centerY
+
+		value := math.Sin(px*g.mFrequencies[0]+t+g.mPhases[0]) +
+			math.Sin(py*g.mFrequencies[1]+t*0.5+g.mPhases[1]) +
+			math.Sin((px+py)*g.mFrequencies[2]*0.5+t+g.mPhases[2]) +
+			math.Sin(math.Sqrt(dx*dx+dy*dy)*g.mFrequencies[3]+t+g.mPhases[3])
+
+		//Sum is from -4 to 4, wrap it around the palette
+		shade := value/8 + 0.5 + cycle
+		return g.mPalette.At(shade - math.Floor(shade))
+	})
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"image/color"
+	"math/rand"
+)
+
+//Size of the tiles seen through the water in pixels
+const waterTileSize = 16
+
+//WaterGenerator simulates ripples spreading from raindrops and shows a tiled floor bent through them
+type WaterGenerator struct {
+	//Fraction of a wave's height kept each step
+	mDamping float64
+
+	//Raindrops per second and how big they are
+	mDropRate   float64
+	mDropRadius int
+
+	mPalette *Palette
+
+	//Water height this step and the step before
+	mCurrent  []float64
+	mPrevious []float64
+
+	mWidth  int
+	mHeight int
+
+	//Drop positions and unsimulated time
+	mRandom      *rand.Rand
+	mAccumulator float64
+	mDropTime    float64
+}
+
+//NewWaterGenerator creates water with the given damping and raindrops
+func NewWaterGenerator(damping, dropRate float64, dropRadius int, palette *Palette) *WaterGenerator {
+	return &WaterGenerator{mDamping: damping, mDropRate: dropRate, mDropRadius: dropRadius, mPalette: palette}
+}
+
+//Name gets the generator name
+func (g *WaterGenerator) Name() string {
+	return "water ripples"
+}
+
+//Reset calms the water
+func (g *WaterGenerator) Reset(seed int64, width, height int) {
+	g.mRandom = rand.New(rand.NewSource(seed))
+	g.mCurrent = make([]float64, width*height)
+	g.mPrevious = make([]float64, width*height)
+	g.mWidth, g.mHeight = width, height
+	g.mAccumulator = 0
+	g.mDropTime = 0
+}
+
+//Update drops rain and spreads the waves in fixed steps
+func (g *WaterGenerator) Update(seconds float64) {
+	g.mAccumulator += seconds
+	for g.mAccumulator >= generatorStep {
+		//Rain
+		if g.mDropRate > 0 {
+			g.mDropTime += generatorStep
+			for g.mDropTime >= 1/g.mDropRate {
+				g.Poke(g.mRandom.Intn(g.mWidth), g.mRandom.Intn(g.mHeight))
+				g.mDropTime -= 1 / g.mDropRate
+			}
+		}
+
+		g.step()
+		g.mAccumulator -= generatorStep
+	}
+}
+
+//Render bends the floor through the water's slope and lights the slopes facing up and left
+func (g *WaterGenerator) Render(pixels *PixelAccessor) {
+	fillPixels(pixels, func(x, y int) color.RGBA {
+		//Slope of the water surface
+		slopeX := g.height(x-1, y) - g.height(x+1, y)
+		slopeY := g.height(x, y-1) - g.height(x, y+1)
+
+		//Look at the floor through the bent surface
+		floorX := x + int(slopeX/8)
+		floorY := y + int(slopeY/8)
+		shade := 0.25 + 0.5*float64(floorY)/float64(g.mHeight)
+		if ((floorX/waterTileSize)+(floorY/waterTileSize))&1 == 0 {
+			shade += 0.1
+		}
+
+		return g.mPalette.At(shade + (slopeX+slopeY)/512)
+	})
+}
+
+//Poke drops a ripple at a position
+func (g *WaterGenerator) Poke(x, y int) {
+	radius := g.mDropRadius
+	for dy := -radius; dy <= radius; dy++ {
+		for dx := -radius; dx <= radius; dx++ {
+			if dx*dx+dy*dy > radius*radius {
+				continue
+			}
+			if px, py := x+dx, y+dy; px >= 0 && py >= 0 && px < g.mWidth && py < g.mHeight {
+				g.mCurrent[py*g.mWidth+px] -= 256
+			}
+		}
+	}
+}
+
+//step runs the wave equation, every height pulls toward its neighbours and overshoots
+func (g *WaterGenerator) step() {
+	for y := 0; y < g.mHeight; y++ {
+		for x := 0; x < g.mWidth; x++ {
+			i := y*g.mWidth + x
+			next := (g.height(x-1, y)+g.height(x+1, y)+g.height(x, y-1)+g.height(x, y+1))/2 - g.mPrevious[i]
+			g.mPrevious[i] = next * g.mDamping
+		}
+	}
+
+	//The next step is written over the previous one
+	g.mCurrent, g.mPrevious = g.mPrevious, g.mCurrent
+}
+
+//height gets the water height at a position, the edges are flat
+func (g *WaterGenerator) height(x, y int) float64 {
+	if x < 0 || y < 0 || x >= g.mWidth || y >= g.mHeight {
+		return 0
+	}
+
+	return g.mCurrent[y*g.mWidth+x]
+}
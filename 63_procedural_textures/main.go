@@ -0,0 +1,294 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"image/color"
+	"log"
+
+	"github.com/veandco/go-sdl2/img"
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/veandco/go-sdl2/ttf"
+)
+
+const (
+	//Screen dimension constants
+	screenWitdh  = 640
+	screenHeight = 480
+
+	//Generated texture dimensions, stretched over the screen
+	textureWidth  = 320
+	textureHeight = 240
+
+	//Seconds each generator is shown for in demo mode
+	demoSeconds = 6
+)
+
+var (
+	//The window we'll be rendering to
+	gWindow *sdl.Window
+
+	//The window renderer
+	gRenderer *sdl.Renderer
+
+	//Globally used font
+	gFont *ttf.Font
+
+	//Scene textures
+	gStreamingTexture LTexture
+
+	//Texture generators and the one being shown
+	gGenerators       []Generator
+	gCurrentGenerator int
+
+	//Generator options
+	generatorSeed = flag.Int64("seed", 1, "seed of the generators, the same seed always gives the same animation")
+	demoMode      = flag.Bool("demo", false, "cycle through the generators")
+)
+
+func main() {
+	flag.Parse()
+
+	//Start up SDL and create window
+	if err := initSDl(); err != nil {
+		log.Fatalf("Could not init SDL: %v\n", err)
+	}
+
+	//Load media
+	if err := loadMedia(); err != nil {
+		log.Fatalf("Could not load media: %v\n", err)
+	}
+
+	//Main loop flag
+	var quit bool
+
+	//Caption shown in the window title
+	var caption string
+
+	//Event handler
+	var e sdl.Event
+
+	//Keeps track of time between steps and how long the generator has been shown
+	var stepTimer LTimer
+	var shownTime float64
+
+	//Start with the first generator
+	seed := *generatorSeed
+	selectGenerator(0, seed)
+	stepTimer.Start()
+
+	//While application is running
+	for !quit {
+		//Handle events on queue
+		for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+			//User requests quit
+			if e.GetType() == sdl.QUIT {
+				quit = true
+			}
+
+			if e.GetType() == sdl.KEYDOWN && (e.(*sdl.KeyboardEvent)).Repeat == 0 {
+				switch key := (e.(*sdl.KeyboardEvent)).Keysym.Sym; key {
+				//Pick a generator
+				case sdl.K_1, sdl.K_2, sdl.K_3, sdl.K_4, sdl.K_5, sdl.K_6:
+					if index := int(key - sdl.K_1); index < len(gGenerators) {
+						selectGenerator(index, seed)
+						shownTime = 0
+					}
+					break
+
+				//Try the next seed
+				case sdl.K_SPACE:
+					seed++
+					selectGenerator(gCurrentGenerator, seed)
+					shownTime = 0
+					break
+
+				//Start or stop the demo
+				case sdl.K_d:
+					*demoMode = !*demoMode
+					shownTime = 0
+					break
+				}
+			}
+
+			//Disturb the generator where it's clicked
+			if e.GetType() == sdl.MOUSEBUTTONDOWN {
+				if interactive, ok := gGenerators[gCurrentGenerator].(Interactive); ok {
+					button := e.(*sdl.MouseButtonEvent)
+					interactive.Poke(int(button.X), int(button.Y))
+				}
+			}
+		}
+
+		//Advance the animation
+		seconds := float64(stepTimer.GetTicks()) / 1000
+		stepTimer.Start()
+		gGenerators[gCurrentGenerator].Update(seconds)
+
+		//Move on to the next generator in demo mode
+		shownTime += seconds
+		if *demoMode && shownTime >= demoSeconds {
+			selectGenerator((gCurrentGenerator+1)%len(gGenerators), seed)
+			shownTime = 0
+		}
+
+		//Show which generator is running
+		newCaption := fmt.Sprintf("SDL Tutorial - %v, seed %d", gGenerators[gCurrentGenerator].Name(), seed)
+		if *demoMode {
+			newCaption += ", demo"
+		}
+		if newCaption != caption {
+			caption = newCaption
+			gWindow.SetTitle(caption)
+		}
+
+		//Generate the frame straight into the texture
+		if err := gStreamingTexture.LockTexture(); err != nil {
+			log.Fatal(err)
+		}
+		pixels, err := gStreamingTexture.Pixels()
+		if err != nil {
+			log.Fatal(err)
+		}
+		gGenerators[gCurrentGenerator].Render(pixels)
+		if err := gStreamingTexture.UnlockTexture(); err != nil {
+			log.Fatal(err)
+		}
+
+		//Clear screen
+		err = gRenderer.SetDrawColor(255, 255, 255, 255)
+		if err != nil {
+			log.Fatalf("could not set draw color for renderer: %v", err)
+		}
+		err = gRenderer.Clear()
+		if err != nil {
+			log.Fatalf("could not clear renderer: %v", err)
+		}
+
+		//Render frame
+		err = gStreamingTexture.Render(0, 0, nil, 0, nil, sdl.FLIP_NONE)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		//Update screen
+		gRenderer.Present()
+	}
+
+	//Free resources and close SDL
+	if err := close(); err != nil {
+		log.Fatalf("Could not close SDL! SDL Error: %v\n", err)
+	}
+}
+
+func initSDl() error {
+	//Local error declaration
+	var err error
+
+	//Initialize SDL
+	if err := sdl.Init(sdl.INIT_VIDEO); err != nil {
+		return fmt.Errorf("SDL could not initialize! SDL_ERROR: %v", err)
+	}
+
+	//Set texture filtering to linear
+	if !sdl.SetHint(sdl.HINT_RENDER_SCALE_QUALITY, "1") {
+		fmt.Printf("Warning: Linear texture filtering not enabled!")
+	}
+
+	//Create Window
+	gWindow, err = sdl.CreateWindow("SDL Tutorial", sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED,
+		screenWitdh, screenHeight, sdl.WINDOW_SHOWN)
+	if err != nil {
+		return fmt.Errorf("Window could not be created! SDL_Error: %v", err)
+	}
+
+	//Create vsynced renderer for window
+	if gRenderer, err = sdl.CreateRenderer(gWindow, -1, sdl.RENDERER_ACCELERATED|sdl.RENDERER_PRESENTVSYNC); err != nil {
+		return fmt.Errorf("Renderer could not be created! SDL Error: %v", err)
+	}
+
+	//Draw at texture resolution and let SDL stretch it and scale mouse positions
+	if err := gRenderer.SetLogicalSize(textureWidth, textureHeight); err != nil {
+		return fmt.Errorf("could not set renderer logical size: %v", err)
+	}
+
+	//Initialize renderer color
+	gRenderer.SetDrawColor(255, 255, 255, 255)
+
+	//Initialize PNG loading
+	imgFlags := img.INIT_PNG
+	if (img.Init(imgFlags) & imgFlags) == 0 {
+		return fmt.Errorf("SDL_image could not initialize! SDL_image Error: %v", img.GetError())
+	}
+
+	//Initialize SDL_ttf
+	if err := ttf.Init(); err != nil {
+		return fmt.Errorf("SDL_ttf could not initialize! SDL_ttf Error: %v", err)
+	}
+
+	return nil
+}
+
+func loadMedia() error {
+	//Load blank texture
+	if err := gStreamingTexture.CreateBlank(textureWidth, textureHeight); err != nil {
+		return fmt.Errorf("failed to create streaming texture: %v", err)
+	}
+
+	//Palettes
+	clouds := NewGradientPalette(color.RGBA{R: 20, G: 60, B: 140, A: 255}, color.RGBA{R: 90, G: 150, B: 220, A: 255},
+		color.RGBA{R: 240, G: 240, B: 250, A: 255})
+	lava := NewGradientPalette(color.RGBA{R: 30, G: 0, B: 0, A: 255}, color.RGBA{R: 180, G: 20, B: 0, A: 255},
+		color.RGBA{R: 255, G: 160, B: 0, A: 255}, color.RGBA{R: 255, G: 255, B: 200, A: 255})
+	rainbow := NewGradientPalette(color.RGBA{R: 255, G: 0, B: 0, A: 255}, color.RGBA{R: 255, G: 255, B: 0, A: 255},
+		color.RGBA{R: 0, G: 255, B: 0, A: 255}, color.RGBA{R: 0, G: 255, B: 255, A: 255}, color.RGBA{R: 0, G: 0, B: 255, A: 255},
+		color.RGBA{R: 255, G: 0, B: 255, A: 255}, color.RGBA{R: 255, G: 0, B: 0, A: 255})
+	fire := NewGradientPalette(color.RGBA{R: 0, G: 0, B: 0, A: 255}, color.RGBA{R: 120, G: 10, B: 0, A: 255},
+		color.RGBA{R: 230, G: 80, B: 0, A: 255}, color.RGBA{R: 255, G: 200, B: 40, A: 255}, color.RGBA{R: 255, G: 255, B: 255, A: 255})
+	water := NewGradientPalette(color.RGBA{R: 0, G: 20, B: 60, A: 255}, color.RGBA{R: 0, G: 110, B: 160, A: 255},
+		color.RGBA{R: 200, G: 240, B: 255, A: 255})
+	life := NewGradientPalette(color.RGBA{R: 10, G: 10, B: 30, A: 255}, color.RGBA{R: 60, G: 20, B: 120, A: 255},
+		color.RGBA{R: 255, G: 255, B: 255, A: 255})
+
+	//Generators in key order
+	gGenerators = []Generator{
+		NewNoiseGenerator(noisePerlin, 64, 4, 0.5, 0.3, clouds),
+		NewNoiseGenerator(noiseSimplex, 48, 3, 0.5, 0.4, lava),
+		NewPlasmaGenerator(24, 1.5, 0.1, rainbow),
+		NewFireGenerator(6, 0, fire),
+		NewWaterGenerator(0.97, 3, 3, water),
+		NewLifeGenerator(0.3, 20, life),
+	}
+
+	return nil
+}
+
+func close() error {
+	//Free loaded images
+	if err := gStreamingTexture.Free(); err != nil {
+		return fmt.Errorf("could not free streaming texture: %v", err)
+	}
+
+	//Destroy window
+	if err := gRenderer.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy global renderer: %v", err)
+	}
+	if err := gWindow.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy window: %v", err)
+	}
+	gWindow = nil
+	gRenderer = nil
+
+	//Quit SDL Subsystems
+	ttf.Quit()
+	img.Quit()
+	sdl.Quit()
+
+	return nil
+}
+
+//selectGenerator shows a generator starting over from a seed
+func selectGenerator(index int, seed int64) {
+	gCurrentGenerator = index
+	gGenerators[index].Reset(seed, textureWidth, textureHeight)
+}
@@ -0,0 +1,735 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	//Most a miter join can stick out, in half thicknesses, before it's cut short
+	primitivesMiterLimit = 4
+
+	//Pixels covered by one segment of a flattened curve
+	primitivesSegmentLength = 4
+)
+
+//Primitives draws shapes beyond rects and lines in the renderer's draw color
+//Outlines, circles, ellipses and rounded rects use midpoint algorithms, everything else is
+//split into triangles and drawn with RenderGeometry, or scanlines if SDL doesn't have it
+type Primitives struct {
+	//Renderer everything is drawn with
+	mRenderer *sdl.Renderer
+
+	//SDL_RenderGeometry is available and turned on
+	mGeometrySupported bool
+	mGeometryEnabled   bool
+
+	//Scratch space reused between shapes
+	mPoints   []sdl.Point
+	mSpans    []sdl.Rect
+	mWidths   []int32
+	mPath     []sdl.FPoint
+	mVertices []sdl.Vertex
+	mIndices  []int32
+}
+
+//NewPrimitives creates a shape drawer for a renderer, using RenderGeometry if SDL is new enough
+func NewPrimitives(renderer *sdl.Renderer) *Primitives {
+	//RenderGeometry came with SDL 2.0.18
+	var version sdl.Version
+	sdl.GetVersion(&version)
+	supported := sdl.VERSIONNUM(int(version.Major), int(version.Minor), int(version.Patch)) >= sdl.VERSIONNUM(2, 0, 18)
+	if !supported {
+		fmt.Printf("Warning: SDL %d.%d.%d has no RenderGeometry, filling shapes with scanlines!\n",
+			version.Major, version.Minor, version.Patch)
+	}
+
+	return &Primitives{mRenderer: renderer, mGeometrySupported: supported, mGeometryEnabled: supported}
+}
+
+//SetGeometryEnabled picks between RenderGeometry and scanlines for triangles
+//RenderGeometry can't be turned on if SDL doesn't support it
+func (p *Primitives) SetGeometryEnabled(enabled bool) {
+	p.mGeometryEnabled = enabled && p.mGeometrySupported
+}
+
+//IsGeometryEnabled checks if triangles are drawn with RenderGeometry
+func (p *Primitives) IsGeometryEnabled() bool {
+	return p.mGeometryEnabled
+}
+
+//DrawCircle draws a one pixel circle outline
+func (p *Primitives) DrawCircle(x, y, radius int32) error {
+	return p.DrawEllipse(x, y, radius, radius)
+}
+
+//FillCircle draws a filled circle covering the same pixels as its outline
+func (p *Primitives) FillCircle(x, y, radius int32) error {
+	return p.FillEllipse(x, y, radius, radius)
+}
+
+//DrawEllipse draws a one pixel ellipse outline with its radii along the axes
+func (p *Primitives) DrawEllipse(x, y, radiusX, radiusY int32) error {
+	if radiusX < 0 || radiusY < 0 {
+		return nil
+	}
+
+	p.mPoints = p.mPoints[:0]
+	ellipseQuadrant(radiusX, radiusY, func(dx, dy int32) {
+		p.addQuadrantPoints(x, y, x, y, dx, dy)
+	})
+
+	return p.drawPoints()
+}
+
+//FillEllipse draws a filled ellipse covering the same pixels as its outline
+func (p *Primitives) FillEllipse(x, y, radiusX, radiusY int32) error {
+	if radiusX < 0 || radiusY < 0 {
+		return nil
+	}
+
+	p.mSpans = p.mSpans[:0]
+	for dy, width := range p.quadrantWidths(radiusX, radiusY) {
+		p.addQuadrantSpans(x, y, x, y, width, int32(dy))
+	}
+
+	return p.fillSpans()
+}
+
+//DrawArc draws a one pixel part of a circle outline
+//Angles are in degrees clockwise from the right, like Copy's rotation
+func (p *Primitives) DrawArc(x, y, radius int32, start, end float64) error {
+	if radius < 0 {
+		return nil
+	}
+
+	p.mPoints = p.mPoints[:0]
+	ellipseQuadrant(radius, radius, func(dx, dy int32) {
+		p.addQuadrantPoints(x, y, x, y, dx, dy)
+	})
+
+	//Keep the circle's pixels that are within the angles
+	kept := p.mPoints[:0]
+	for _, point := range p.mPoints {
+		angle := math.Atan2(float64(point.Y-y), float64(point.X-x)) * 180 / math.Pi
+		if angleWithin(angle, start, end) {
+			kept = append(kept, point)
+		}
+	}
+	p.mPoints = kept
+
+	return p.drawPoints()
+}
+
+//FillPie draws a filled slice of a circle between two angles
+//Angles are in degrees clockwise from the right, like Copy's rotation
+func (p *Primitives) FillPie(x, y, radius int32, start, end float64) error {
+	if radius < 0 {
+		return nil
+	}
+
+	sweep := end - start
+	if sweep <= 0 {
+		return nil
+	}
+	if sweep > 360 {
+		sweep = 360
+	}
+
+	//Cover the pixels whose centers the circle outline goes through
+	centerX, centerY := float64(x)+0.5, float64(y)+0.5
+	r := float64(radius) + 0.5
+
+	//Fan out from the center
+	segments := curveSegments(2 * math.Pi * r * sweep / 360)
+	p.mPath = append(p.mPath[:0], sdl.FPoint{X: float32(centerX), Y: float32(centerY)})
+	for i := 0; i <= segments; i++ {
+		angle := (start + sweep*float64(i)/float64(segments)) * math.Pi / 180
+		p.mPath = append(p.mPath, sdl.FPoint{X: float32(centerX + math.Cos(angle)*r), Y: float32(centerY + math.Sin(angle)*r)})
+	}
+
+	p.mIndices = p.mIndices[:0]
+	for i := 1; i <= segments; i++ {
+		p.mIndices = append(p.mIndices, 0, int32(i), int32(i+1))
+	}
+
+	return p.fillTriangles(p.mPath, p.mIndices)
+}
+
+//DrawRoundedRect draws a one pixel rect outline with round corners
+//The radius is cut down to fit the rect
+func (p *Primitives) DrawRoundedRect(rect *sdl.Rect, radius int32) error {
+	radius = roundedRadius(rect, radius)
+	if radius <= 0 {
+		if err := p.mRenderer.DrawRect(rect); err != nil {
+			return fmt.Errorf("could not draw rect: %v", err)
+		}
+		return nil
+	}
+
+	//Corner centers
+	left, top := rect.X+radius, rect.Y+radius
+	right, bottom := rect.X+rect.W-1-radius, rect.Y+rect.H-1-radius
+
+	p.mPoints = p.mPoints[:0]
+	ellipseQuadrant(radius, radius, func(dx, dy int32) {
+		p.addQuadrantPoints(left, top, right, bottom, dx, dy)
+	})
+
+	//Straight edges between the corners
+	for x := left + 1; x < right; x++ {
+		p.mPoints = append(p.mPoints, sdl.Point{X: x, Y: rect.Y}, sdl.Point{X: x, Y: rect.Y + rect.H - 1})
+	}
+	for y := top + 1; y < bottom; y++ {
+		p.mPoints = append(p.mPoints, sdl.Point{X: rect.X, Y: y}, sdl.Point{X: rect.X + rect.W - 1, Y: y})
+	}
+
+	return p.drawPoints()
+}
+
+//FillRoundedRect draws a filled rect with round corners
+//The radius is cut down to fit the rect
+func (p *Primitives) FillRoundedRect(rect *sdl.Rect, radius int32) error {
+	radius = roundedRadius(rect, radius)
+	if radius <= 0 {
+		if err := p.mRenderer.FillRect(rect); err != nil {
+			return fmt.Errorf("could not fill rect: %v", err)
+		}
+		return nil
+	}
+
+	//Corner centers
+	left, top := rect.X+radius, rect.Y+radius
+	right, bottom := rect.X+rect.W-1-radius, rect.Y+rect.H-1-radius
+
+	//Rows through the corners
+	p.mSpans = p.mSpans[:0]
+	for dy, width := range p.quadrantWidths(radius, radius) {
+		p.addQuadrantSpans(left, top, right, bottom, width, int32(dy))
+	}
+
+	//Full width rows between them
+	if bottom > top+1 {
+		p.mSpans = append(p.mSpans, sdl.Rect{X: rect.X, Y: top + 1, W: rect.W, H: bottom - top - 1})
+	}
+
+	return p.fillSpans()
+}
+
+//DrawThickLine draws a line of any thickness with square ends at the points
+func (p *Primitives) DrawThickLine(x1, y1, x2, y2, thickness float32) error {
+	return p.DrawPolyline([]sdl.FPoint{{X: x1, Y: y1}, {X: x2, Y: y2}}, thickness)
+}
+
+//DrawPolyline draws connected lines of any thickness with mitered joins
+func (p *Primitives) DrawPolyline(points []sdl.FPoint, thickness float32) error {
+	return p.stroke(points, false, thickness)
+}
+
+//DrawPolygon draws a closed polygon outline of any thickness with mitered joins
+func (p *Primitives) DrawPolygon(points []sdl.FPoint, thickness float32) error {
+	return p.stroke(points, true, thickness)
+}
+
+//FillPolygon draws a filled polygon, convex or concave, as long as its edges don't cross
+func (p *Primitives) FillPolygon(points []sdl.FPoint) error {
+	indices, err := Triangulate(points)
+	if err != nil {
+		return err
+	}
+
+	return p.fillTriangles(points, indices)
+}
+
+//DrawQuadraticBezier draws a curve from p0 to p2 bent toward p1
+func (p *Primitives) DrawQuadraticBezier(p0, p1, p2 sdl.FPoint, thickness float32) error {
+	segments := curveSegments(distance(p0, p1) + distance(p1, p2))
+
+	//The stroke reuses mPath so the curve needs its own slice
+	curve := make([]sdl.FPoint, 0, segments+1)
+	for i := 0; i <= segments; i++ {
+		t := float32(i) / float32(segments)
+		u := 1 - t
+		curve = append(curve, sdl.FPoint{
+			X: u*u*p0.X + 2*u*t*p1.X + t*t*p2.X,
+			Y: u*u*p0.Y + 2*u*t*p1.Y + t*t*p2.Y,
+		})
+	}
+
+	return p.stroke(curve, false, thickness)
+}
+
+//DrawCubicBezier draws a curve from p0 to p3 leaving toward p1 and arriving from p2
+func (p *Primitives) DrawCubicBezier(p0, p1, p2, p3 sdl.FPoint, thickness float32) error {
+	segments := curveSegments(distance(p0, p1) + distance(p1, p2) + distance(p2, p3))
+
+	//The stroke reuses mPath so the curve needs its own slice
+	curve := make([]sdl.FPoint, 0, segments+1)
+	for i := 0; i <= segments; i++ {
+		t := float32(i) / float32(segments)
+		u := 1 - t
+		curve = append(curve, sdl.FPoint{
+			X: u*u*u*p0.X + 3*u*u*t*p1.X + 3*u*t*t*p2.X + t*t*t*p3.X,
+			Y: u*u*u*p0.Y + 3*u*u*t*p1.Y + 3*u*t*t*p2.Y + t*t*t*p3.Y,
+		})
+	}
+
+	return p.stroke(curve, false, thickness)
+}
+
+//Triangulate splits a polygon into triangles by clipping ears, giving three point indices per triangle
+//The polygon can go either way round and be concave but its edges can't cross
+func Triangulate(points []sdl.FPoint) ([]int32, error) {
+	if len(points) < 3 {
+		return nil, fmt.Errorf("polygon needs at least 3 points, got %d", len(points))
+	}
+
+	//Which way round the polygon goes, ears turn the same way
+	area := polygonArea(points)
+	if area == 0 {
+		return nil, fmt.Errorf("polygon has no area")
+	}
+	if edgesCross(points) {
+		return nil, fmt.Errorf("polygon edges cross")
+	}
+
+	remaining := make([]int32, len(points))
+	for i := range remaining {
+		remaining[i] = int32(i)
+	}
+
+	indices := make([]int32, 0, (len(points)-2)*3)
+	for len(remaining) > 3 {
+		clipped := false
+		for i := range remaining {
+			prev := remaining[(i+len(remaining)-1)%len(remaining)]
+			current := remaining[i]
+			next := remaining[(i+1)%len(remaining)]
+
+			if !isEar(points, remaining, prev, current, next, area) {
+				continue
+			}
+
+			//Cut the ear off
+			indices = append(indices, prev, current, next)
+			remaining = append(remaining[:i], remaining[i+1:]...)
+			clipped = true
+			break
+		}
+
+		if !clipped {
+			return nil, fmt.Errorf("could not triangulate polygon, its edges may cross")
+		}
+	}
+
+	return append(indices, remaining...), nil
+}
+
+//stroke draws lines along a path, one pixel wide lines if thickness is 1 or less
+func (p *Primitives) stroke(points []sdl.FPoint, closed bool, thickness float32) error {
+	//Drop repeated points, they have no direction
+	p.mPath = p.mPath[:0]
+	for _, point := range points {
+		if len(p.mPath) == 0 || point != p.mPath[len(p.mPath)-1] {
+			p.mPath = append(p.mPath, point)
+		}
+	}
+	if closed && len(p.mPath) > 1 && p.mPath[0] == p.mPath[len(p.mPath)-1] {
+		p.mPath = p.mPath[:len(p.mPath)-1]
+	}
+	if len(p.mPath) < 2 {
+		return nil
+	}
+
+	if thickness <= 1 {
+		if closed {
+			p.mPath = append(p.mPath, p.mPath[0])
+		}
+		if err := p.mRenderer.DrawLinesF(p.mPath); err != nil {
+			return fmt.Errorf("could not draw lines: %v", err)
+		}
+		return nil
+	}
+
+	//Two points across the line at every point of the path
+	count := len(p.mPath)
+	half := float64(thickness) / 2
+	corners := make([]sdl.FPoint, 0, count*2)
+	for i, point := range p.mPath {
+		var normal [2]float64
+		var scale float64
+
+		hasPrev := closed || i > 0
+		hasNext := closed || i < count-1
+		switch {
+		case hasPrev && hasNext:
+			//Miter between the two segments
+			in := segmentNormal(p.mPath[(i+count-1)%count], point)
+			out := segmentNormal(point, p.mPath[(i+1)%count])
+			normal = normalize(in[0]+out[0], in[1]+out[1])
+			if cos := normal[0]*out[0] + normal[1]*out[1]; cos > 1.0/primitivesMiterLimit {
+				scale = half / cos
+			} else {
+				scale = half * primitivesMiterLimit
+			}
+			break
+
+		case hasNext:
+			normal = segmentNormal(point, p.mPath[i+1])
+			scale = half
+			break
+
+		default:
+			normal = segmentNormal(p.mPath[i-1], point)
+			scale = half
+			break
+		}
+
+		offsetX, offsetY := float32(normal[0]*scale), float32(normal[1]*scale)
+		corners = append(corners,
+			sdl.FPoint{X: point.X + offsetX, Y: point.Y + offsetY},
+			sdl.FPoint{X: point.X - offsetX, Y: point.Y - offsetY})
+	}
+
+	//Two triangles per segment
+	segments := count - 1
+	if closed {
+		segments = count
+	}
+	p.mIndices = p.mIndices[:0]
+	for i := 0; i < segments; i++ {
+		a := int32(i * 2)
+		b := int32(((i + 1) % count) * 2)
+		p.mIndices = append(p.mIndices, a, a+1, b, a+1, b+1, b)
+	}
+
+	return p.fillTriangles(corners, p.mIndices)
+}
+
+//fillTriangles draws indexed triangles in the draw color
+func (p *Primitives) fillTriangles(points []sdl.FPoint, indices []int32) error {
+	if len(indices) == 0 {
+		return nil
+	}
+
+	if p.mGeometryEnabled {
+		r, g, b, a, err := p.mRenderer.GetDrawColor()
+		if err != nil {
+			return fmt.Errorf("could not get draw color: %v", err)
+		}
+		color := sdl.Color{R: r, G: g, B: b, A: a}
+
+		p.mVertices = p.mVertices[:0]
+		for _, point := range points {
+			p.mVertices = append(p.mVertices, sdl.Vertex{Position: point, Color: color})
+		}
+
+		err = p.mRenderer.RenderGeometry(nil, p.mVertices, indices)
+		if err == nil {
+			return nil
+		}
+
+		//Don't try again, the renderer can't do it
+		fmt.Printf("Warning: Could not render geometry, falling back to scanlines! SDL Error: %v\n", err)
+		p.mGeometrySupported = false
+		p.mGeometryEnabled = false
+	}
+
+	p.mSpans = p.mSpans[:0]
+	for i := 0; i+2 < len(indices); i += 3 {
+		p.addTriangleSpans(points[indices[i]], points[indices[i+1]], points[indices[i+2]])
+	}
+
+	return p.fillSpans()
+}
+
+//addTriangleSpans adds a row for every pixel row whose center is inside a triangle
+//Rows and columns are half open so triangles sharing an edge don't cover a pixel twice
+func (p *Primitives) addTriangleSpans(a, b, c sdl.FPoint) {
+	//Sort top to bottom
+	if b.Y < a.Y {
+		a, b = b, a
+	}
+	if c.Y < a.Y {
+		a, c = c, a
+	}
+	if c.Y < b.Y {
+		b, c = c, b
+	}
+
+	for y := int32(math.Ceil(float64(a.Y) - 0.5)); float32(y)+0.5 < c.Y; y++ {
+		centerY := float32(y) + 0.5
+
+		//Between the long edge and whichever short edge is beside this row
+		x1 := edgeX(a, c, centerY)
+		x2 := edgeX(b, c, centerY)
+		if centerY < b.Y {
+			x2 = edgeX(a, b, centerY)
+		}
+		if x2 < x1 {
+			x1, x2 = x2, x1
+		}
+
+		left := int32(math.Ceil(float64(x1) - 0.5))
+		right := int32(math.Ceil(float64(x2) - 0.5))
+		if right > left {
+			p.mSpans = append(p.mSpans, sdl.Rect{X: left, Y: y, W: right - left, H: 1})
+		}
+	}
+}
+
+//addQuadrantPoints adds a quadrant point mirrored around four corner centers
+//Mirrored points landing on the same pixel are only added once so blending stays even
+func (p *Primitives) addQuadrantPoints(left, top, right, bottom, dx, dy int32) {
+	p.mPoints = append(p.mPoints, sdl.Point{X: right + dx, Y: bottom + dy})
+	if left-dx != right+dx {
+		p.mPoints = append(p.mPoints, sdl.Point{X: left - dx, Y: bottom + dy})
+	}
+	if top-dy != bottom+dy {
+		p.mPoints = append(p.mPoints, sdl.Point{X: right + dx, Y: top - dy})
+		if left-dx != right+dx {
+			p.mPoints = append(p.mPoints, sdl.Point{X: left - dx, Y: top - dy})
+		}
+	}
+}
+
+//addQuadrantSpans adds the rows a quadrant width covers above and below the corner centers
+func (p *Primitives) addQuadrantSpans(left, top, right, bottom, width, dy int32) {
+	span := sdl.Rect{X: left - width, Y: bottom + dy, W: right - left + width*2 + 1, H: 1}
+	p.mSpans = append(p.mSpans, span)
+	if top-dy != bottom+dy {
+		span.Y = top - dy
+		p.mSpans = append(p.mSpans, span)
+	}
+}
+
+//quadrantWidths gets how far an ellipse quadrant reaches out on every row from its center down
+func (p *Primitives) quadrantWidths(radiusX, radiusY int32) []int32 {
+	p.mWidths = p.mWidths[:0]
+	for i := int32(0); i <= radiusY; i++ {
+		p.mWidths = append(p.mWidths, 0)
+	}
+
+	ellipseQuadrant(radiusX, radiusY, func(dx, dy int32) {
+		if dx > p.mWidths[dy] {
+			p.mWidths[dy] = dx
+		}
+	})
+
+	return p.mWidths
+}
+
+//drawPoints draws the collected points
+func (p *Primitives) drawPoints() error {
+	if len(p.mPoints) == 0 {
+		return nil
+	}
+
+	if err := p.mRenderer.DrawPoints(p.mPoints); err != nil {
+		return fmt.Errorf("could not draw points: %v", err)
+	}
+
+	return nil
+}
+
+//fillSpans fills the collected rows
+func (p *Primitives) fillSpans() error {
+	if len(p.mSpans) == 0 {
+		return nil
+	}
+
+	if err := p.mRenderer.FillRects(p.mSpans); err != nil {
+		return fmt.Errorf("could not fill rects: %v", err)
+	}
+
+	return nil
+}
+
+//ellipseQuadrant walks the bottom right quarter of an ellipse outline with the midpoint algorithm
+//Every pixel is passed to plot once, starting at the bottom and ending at the right
+func ellipseQuadrant(radiusX, radiusY int32, plot func(dx, dy int32)) {
+	//A flat ellipse is a line
+	if radiusY == 0 {
+		for x := int32(0); x <= radiusX; x++ {
+			plot(x, 0)
+		}
+		return
+	}
+
+	rx2 := float64(radiusX) * float64(radiusX)
+	ry2 := float64(radiusY) * float64(radiusY)
+	x, y := int32(0), radiusY
+
+	//How fast the outline moves in x and y
+	stepX, stepY := 0.0, 2*rx2*float64(y)
+
+	//Flatter than 45 degrees, step along x
+	decision := ry2 - rx2*float64(radiusY) + rx2/4
+	for stepX < stepY {
+		plot(x, y)
+
+		x++
+		stepX += 2 * ry2
+		if decision < 0 {
+			decision += ry2 + stepX
+		} else {
+			y--
+			stepY -= 2 * rx2
+			decision += ry2 + stepX - stepY
+		}
+	}
+
+	//Steeper, step along y
+	midX, midY := float64(x)+0.5, float64(y-1)
+	decision = ry2*midX*midX + rx2*midY*midY - rx2*ry2
+	for y >= 0 {
+		plot(x, y)
+
+		y--
+		stepY -= 2 * rx2
+		if decision > 0 {
+			decision += rx2 - stepY
+		} else {
+			x++
+			stepX += 2 * ry2
+			decision += rx2 - stepY + stepX
+		}
+	}
+}
+
+//roundedRadius cuts a corner radius down so the corners fit in a rect
+func roundedRadius(rect *sdl.Rect, radius int32) int32 {
+	if most := (rect.W - 1) / 2; radius > most {
+		radius = most
+	}
+	if most := (rect.H - 1) / 2; radius > most {
+		radius = most
+	}
+
+	return radius
+}
+
+//angleWithin checks if an angle is between two others going clockwise, all in degrees
+func angleWithin(angle, start, end float64) bool {
+	sweep := end - start
+	if sweep >= 360 {
+		return true
+	}
+	if sweep < 0 {
+		return false
+	}
+
+	return math.Mod(math.Mod(angle-start, 360)+360, 360) <= sweep
+}
+
+//curveSegments gets how many straight pieces a curve of some length is drawn with
+func curveSegments(length float64) int {
+	segments := int(math.Ceil(length / primitivesSegmentLength))
+	if segments < 8 {
+		return 8
+	}
+	if segments > 512 {
+		return 512
+	}
+
+	return segments
+}
+
+//edgeX gets where an edge crosses a row
+func edgeX(a, b sdl.FPoint, y float32) float32 {
+	if b.Y == a.Y {
+		return a.X
+	}
+
+	return a.X + (b.X-a.X)*(y-a.Y)/(b.Y-a.Y)
+}
+
+//distance gets how far apart two points are
+func distance(a, b sdl.FPoint) float64 {
+	return math.Hypot(float64(b.X-a.X), float64(b.Y-a.Y))
+}
+
+//segmentNormal gets the unit vector to the right of a segment
+func segmentNormal(a, b sdl.FPoint) [2]float64 {
+	return normalize(float64(a.Y-b.Y), float64(b.X-a.X))
+}
+
+//normalize scales a vector to length 1
+func normalize(x, y float64) [2]float64 {
+	length := math.Hypot(x, y)
+	if length == 0 {
+		return [2]float64{0, 0}
+	}
+
+	return [2]float64{x / length, y / length}
+}
+
+//cross gets which way the path a, b, c turns at b, the sign matches the polygon area's
+func cross(a, b, c sdl.FPoint) float64 {
+	return float64(b.X-a.X)*float64(c.Y-b.Y) - float64(b.Y-a.Y)*float64(c.X-b.X)
+}
+
+//polygonArea gets twice the signed area of a polygon
+func polygonArea(points []sdl.FPoint) float64 {
+	var area float64
+	for i := range points {
+		a, b := points[i], points[(i+1)%len(points)]
+		area += float64(a.X)*float64(b.Y) - float64(b.X)*float64(a.Y)
+	}
+
+	return area
+}
+
+//edgesCross checks if any two edges of a polygon cross each other
+func edgesCross(points []sdl.FPoint) bool {
+	for i := range points {
+		a, b := points[i], points[(i+1)%len(points)]
+
+		//Edges next to each other share a point, so start two along and stop before wrapping back round
+		for j := i + 2; j < len(points); j++ {
+			if i == 0 && j == len(points)-1 {
+				continue
+			}
+
+			c, d := points[j], points[(j+1)%len(points)]
+			if cross(a, b, c)*cross(a, b, d) < 0 && cross(c, d, a)*cross(c, d, b) < 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+//isEar checks if a corner of what's left of a polygon can be cut off
+//It has to turn the same way as the polygon with none of the other points inside it
+func isEar(points []sdl.FPoint, remaining []int32, prev, current, next int32, area float64) bool {
+	a, b, c := points[prev], points[current], points[next]
+
+	//Reflex corners aren't ears, straight ones can go
+	turn := cross(a, b, c)
+	if turn*area < 0 {
+		return false
+	}
+
+	for _, index := range remaining {
+		if index == prev || index == current || index == next {
+			continue
+		}
+
+		point := points[index]
+		if point == a || point == b || point == c {
+			continue
+		}
+
+		//Inside, or on the edge the cut would make
+		ab, bc, ca := cross(a, b, point), cross(b, c, point), cross(c, a, point)
+		if turn != 0 && ab*turn > 0 && bc*turn > 0 && ca*turn >= 0 {
+			return false
+		}
+	}
+
+	return true
+}
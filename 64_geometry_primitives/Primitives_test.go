@@ -0,0 +1,318 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"image"
+	"image/png"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//Run go test -update to rewrite the golden images after an intended change
+var updateGoldens = flag.Bool("update", false, "rewrite golden images in testdata")
+
+//Size of every golden image
+const goldenSize = 64
+
+//goldenCases draws one primitive each, white on black
+var goldenCases = []struct {
+	name string
+	draw func(p *Primitives) error
+}{
+	{"circle", func(p *Primitives) error { return p.DrawCircle(32, 32, 25) }},
+	{"circle_fill", func(p *Primitives) error { return p.FillCircle(32, 32, 25) }},
+	{"ellipse", func(p *Primitives) error { return p.DrawEllipse(32, 32, 28, 14) }},
+	{"ellipse_fill", func(p *Primitives) error { return p.FillEllipse(32, 32, 14, 28) }},
+	{"arc", func(p *Primitives) error { return p.DrawArc(32, 32, 25, -90, 135) }},
+	{"pie", func(p *Primitives) error { return p.FillPie(32, 32, 25, 30, 300) }},
+	{"rounded_rect", func(p *Primitives) error {
+		return p.DrawRoundedRect(&sdl.Rect{X: 6, Y: 12, W: 52, H: 40}, 12)
+	}},
+	{"rounded_rect_fill", func(p *Primitives) error {
+		return p.FillRoundedRect(&sdl.Rect{X: 6, Y: 12, W: 52, H: 40}, 12)
+	}},
+	{"thick_line", func(p *Primitives) error { return p.DrawThickLine(8, 50, 56, 14, 6) }},
+	{"polyline", func(p *Primitives) error {
+		return p.DrawPolyline([]sdl.FPoint{{X: 6, Y: 50}, {X: 20, Y: 14}, {X: 34, Y: 50}, {X: 48, Y: 14}, {X: 58, Y: 30}}, 4)
+	}},
+	{"polygon", func(p *Primitives) error { return p.DrawPolygon(goldenStar(), 3) }},
+	{"polygon_fill", func(p *Primitives) error { return p.FillPolygon(goldenStar()) }},
+	{"quadratic_bezier", func(p *Primitives) error {
+		return p.DrawQuadraticBezier(sdl.FPoint{X: 6, Y: 56}, sdl.FPoint{X: 32, Y: -20}, sdl.FPoint{X: 58, Y: 56}, 3)
+	}},
+	{"cubic_bezier", func(p *Primitives) error {
+		return p.DrawCubicBezier(sdl.FPoint{X: 6, Y: 32}, sdl.FPoint{X: 20, Y: -10}, sdl.FPoint{X: 44, Y: 74},
+			sdl.FPoint{X: 58, Y: 32}, 1)
+	}},
+}
+
+//goldenStar gets a concave five pointed star in the middle of a golden image
+func goldenStar() []sdl.FPoint {
+	points := make([]sdl.FPoint, 10)
+	for i := range points {
+		radius := 28.0
+		if i%2 == 1 {
+			radius = 11
+		}
+		angle := float64(i)*math.Pi/5 - math.Pi/2
+		points[i] = sdl.FPoint{X: float32(32 + radius*math.Cos(angle)), Y: float32(32 + radius*math.Sin(angle))}
+	}
+
+	return points
+}
+
+//renderGolden draws a case into a software renderer and reads back the pixels
+//Triangles are filled with scanlines, so the result is the same whichever SDL version runs the test
+func renderGolden(draw func(p *Primitives) error) (*image.RGBA, error) {
+	surface, err := sdl.CreateRGBSurfaceWithFormat(0, goldenSize, goldenSize, 32, uint32(sdl.PIXELFORMAT_RGBA32))
+	if err != nil {
+		return nil, fmt.Errorf("could not create surface: %v", err)
+	}
+	defer surface.Free()
+
+	renderer, err := sdl.CreateSoftwareRenderer(surface)
+	if err != nil {
+		return nil, fmt.Errorf("could not create software renderer: %v", err)
+	}
+	defer renderer.Destroy()
+
+	renderer.SetDrawColor(0, 0, 0, 255)
+	renderer.Clear()
+	renderer.SetDrawColor(255, 255, 255, 255)
+
+	primitives := NewPrimitives(renderer)
+	primitives.SetGeometryEnabled(false)
+	if err := draw(primitives); err != nil {
+		return nil, err
+	}
+	renderer.Present()
+
+	//Copy the rows out of the surface
+	img := image.NewRGBA(image.Rect(0, 0, goldenSize, goldenSize))
+	if err := surface.Lock(); err != nil {
+		return nil, fmt.Errorf("could not lock surface: %v", err)
+	}
+	pixels := surface.Pixels()
+	for y := 0; y < goldenSize; y++ {
+		copy(img.Pix[y*img.Stride:(y+1)*img.Stride], pixels[y*int(surface.Pitch):])
+	}
+	surface.Unlock()
+
+	return img, nil
+}
+
+//readGolden loads a golden image as RGBA
+func readGolden(path string) (*image.RGBA, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	decoded, err := png.Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode %v: %v", path, err)
+	}
+
+	img := image.NewRGBA(decoded.Bounds())
+	for y := decoded.Bounds().Min.Y; y < decoded.Bounds().Max.Y; y++ {
+		for x := decoded.Bounds().Min.X; x < decoded.Bounds().Max.X; x++ {
+			img.Set(x, y, decoded.At(x, y))
+		}
+	}
+
+	return img, nil
+}
+
+//writePNG saves an image
+func writePNG(path string, img image.Image) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := png.Encode(file, img); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func TestPrimitivesGolden(t *testing.T) {
+	for _, c := range goldenCases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := renderGolden(c.draw)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			path := filepath.Join("testdata", c.name+".png")
+			if *updateGoldens {
+				if err := writePNG(path, got); err != nil {
+					t.Fatalf("could not write golden: %v", err)
+				}
+				return
+			}
+
+			want, err := readGolden(path)
+			if err != nil {
+				t.Fatalf("could not read golden, run go test -update to create it: %v", err)
+			}
+			if want.Bounds() != got.Bounds() {
+				t.Fatalf("golden is %v, rendered %v", want.Bounds(), got.Bounds())
+			}
+
+			if !bytes.Equal(want.Pix, got.Pix) {
+				//Keep what was drawn to compare by eye
+				actual := filepath.Join(os.TempDir(), c.name+"_actual.png")
+				writePNG(actual, got)
+
+				different := 0
+				for i := 0; i < len(want.Pix); i += 4 {
+					if !bytes.Equal(want.Pix[i:i+4], got.Pix[i:i+4]) {
+						different++
+					}
+				}
+				t.Errorf("%d pixels differ from %v, rendered image saved to %v", different, path, actual)
+			}
+		})
+	}
+}
+
+//triangleArea gets twice the area of a triangle, whichever way round it goes
+func triangleArea(a, b, c sdl.FPoint) float64 {
+	return math.Abs(float64(b.X-a.X)*float64(c.Y-a.Y) - float64(b.Y-a.Y)*float64(c.X-a.X))
+}
+
+func TestTriangulate(t *testing.T) {
+	cases := []struct {
+		name   string
+		points []sdl.FPoint
+	}{
+		{"square", []sdl.FPoint{{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 10}, {X: 0, Y: 10}}},
+		{"star", goldenStar()},
+		{"u", []sdl.FPoint{{X: 0, Y: 0}, {X: 3, Y: 0}, {X: 3, Y: 7}, {X: 7, Y: 7}, {X: 7, Y: 0}, {X: 10, Y: 0},
+			{X: 10, Y: 10}, {X: 0, Y: 10}}},
+		{"collinear", []sdl.FPoint{{X: 0, Y: 0}, {X: 5, Y: 0}, {X: 10, Y: 0}, {X: 10, Y: 5}, {X: 10, Y: 10},
+			{X: 5, Y: 5}, {X: 0, Y: 10}}},
+	}
+
+	for _, c := range cases {
+		//Both ways round
+		reversed := make([]sdl.FPoint, len(c.points))
+		for i, point := range c.points {
+			reversed[len(reversed)-1-i] = point
+		}
+
+		for winding, points := range [][]sdl.FPoint{c.points, reversed} {
+			indices, err := Triangulate(points)
+			if err != nil {
+				t.Errorf("%v winding %d: %v", c.name, winding, err)
+				continue
+			}
+
+			//A polygon with n points splits into n-2 triangles
+			if len(indices) != (len(points)-2)*3 {
+				t.Errorf("%v winding %d: got %d indices, want %d", c.name, winding, len(indices), (len(points)-2)*3)
+				continue
+			}
+
+			//The triangles cover the polygon exactly
+			var area float64
+			for i := 0; i < len(indices); i += 3 {
+				area += triangleArea(points[indices[i]], points[indices[i+1]], points[indices[i+2]])
+			}
+			if want := math.Abs(polygonArea(points)); math.Abs(area-want) > 1e-3 {
+				t.Errorf("%v winding %d: triangles cover %v, want %v", c.name, winding, area/2, want/2)
+			}
+		}
+	}
+
+	//Polygons that can't be filled
+	bad := map[string][]sdl.FPoint{
+		"too few points": {{X: 0, Y: 0}, {X: 1, Y: 1}},
+		"bowtie":         {{X: 0, Y: 0}, {X: 4, Y: 4}, {X: 4, Y: 0}, {X: 0, Y: 4}},
+		"crossed":        {{X: 0, Y: 0}, {X: 10, Y: 0}, {X: 0, Y: 10}, {X: 10, Y: 10}, {X: 12, Y: 5}},
+		"line":           {{X: 0, Y: 0}, {X: 5, Y: 5}, {X: 10, Y: 10}},
+	}
+	for name, points := range bad {
+		if _, err := Triangulate(points); err == nil {
+			t.Errorf("%v: want an error", name)
+		}
+	}
+}
+
+func TestAngleWithin(t *testing.T) {
+	cases := []struct {
+		angle, start, end float64
+		want              bool
+	}{
+		{45, 0, 90, true},
+		{0, 0, 90, true},
+		{90, 0, 90, true},
+		{91, 0, 90, false},
+		{-10, 0, 90, false},
+		//Angles wrap around a full turn
+		{350, -20, 20, true},
+		{-350, 0, 20, true},
+		{450, 0, 180, true},
+		{180, 270, 450, false},
+		{0, 270, 450, true},
+		//A full turn covers everything, a backwards sweep nothing
+		{123, 0, 360, true},
+		{10, 90, 0, false},
+	}
+
+	for _, c := range cases {
+		if got := angleWithin(c.angle, c.start, c.end); got != c.want {
+			t.Errorf("angleWithin(%v, %v, %v) = %v, want %v", c.angle, c.start, c.end, got, c.want)
+		}
+	}
+}
+
+func TestEllipseQuadrant(t *testing.T) {
+	for _, radii := range [][2]int32{{0, 0}, {1, 1}, {5, 5}, {25, 25}, {28, 14}, {14, 28}, {40, 3}, {7, 0}, {0, 7}} {
+		radiusX, radiusY := radii[0], radii[1]
+
+		var points [][2]int32
+		ellipseQuadrant(radiusX, radiusY, func(dx, dy int32) {
+			points = append(points, [2]int32{dx, dy})
+		})
+
+		//Starts at the bottom and ends at the right
+		if len(points) == 0 || points[0] != [2]int32{0, radiusY} || points[len(points)-1] != [2]int32{radiusX, 0} {
+			t.Errorf("%dx%d: walk goes from %v to %v, want (0, %d) to (%d, 0)", radiusX, radiusY,
+				points[0], points[len(points)-1], radiusY, radiusX)
+			continue
+		}
+
+		for i, point := range points {
+			//Each pixel is a step right, up or diagonal from the last with no gaps or repeats
+			if i > 0 {
+				dx, dy := point[0]-points[i-1][0], points[i-1][1]-point[1]
+				if dx < 0 || dx > 1 || dy < 0 || dy > 1 || dx+dy == 0 {
+					t.Errorf("%dx%d: step from %v to %v", radiusX, radiusY, points[i-1], point)
+				}
+			}
+
+			//And within half a pixel of the true outline
+			if radiusX > 0 && radiusY > 0 {
+				x, y := float64(point[0]), float64(point[1])
+				rx, ry := float64(radiusX), float64(radiusY)
+				nearX, nearY := math.Max(x-0.5, 0), math.Max(y-0.5, 0)
+				inside := nearX*nearX/(rx*rx) + nearY*nearY/(ry*ry)
+				outside := (x+0.5)*(x+0.5)/(rx*rx) + (y+0.5)*(y+0.5)/(ry*ry)
+				if inside > 1 || outside < 1 {
+					t.Errorf("%dx%d: %v is more than half a pixel off the outline", radiusX, radiusY, point)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"github.com/veandco/go-sdl2/img"
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	//Screen dimension constants
+	screenWitdh  = 640
+	screenHeight = 480
+)
+
+var (
+	//The window we'll be rendering to
+	gWindow *sdl.Window
+
+	//The window renderer
+	gRenderer *sdl.Renderer
+
+	//Shape drawer
+	gPrimitives *Primitives
+)
+
+func main() {
+	//Start up SDL and create window
+	if err := initSDl(); err != nil {
+		log.Fatalf("Could not init SDL: %v\n", err)
+	}
+
+	//Load media
+	if err := loadMedia(); err != nil {
+		log.Fatalf("Could not load media: %v\n", err)
+	}
+
+	//Main loop flag
+	quit := false
+
+	//Event handler
+	var e sdl.Event
+
+	//Caption shown in the window title
+	var caption string
+
+	//Thickness of the lines and curves
+	var thickness float32 = 6
+
+	//Bezier control point dragged by the mouse
+	control := sdl.FPoint{X: 320, Y: 330}
+
+	//While application is running
+	for !quit {
+		//Handle events on queue
+		for e = sdl.PollEvent(); e != nil; e = sdl.PollEvent() {
+			//User requests quit
+			if e.GetType() == sdl.QUIT {
+				quit = true
+			}
+
+			if e.GetType() == sdl.KEYDOWN {
+				switch (e.(*sdl.KeyboardEvent)).Keysym.Sym {
+				//Switch between RenderGeometry and scanlines
+				case sdl.K_g:
+					gPrimitives.SetGeometryEnabled(!gPrimitives.IsGeometryEnabled())
+					break
+
+				//Thicken lines
+				case sdl.K_UP:
+					if thickness < 32 {
+						thickness++
+					}
+					break
+
+				//Thin lines
+				case sdl.K_DOWN:
+					if thickness > 1 {
+						thickness--
+					}
+					break
+				}
+			}
+
+			//Move the control point with the mouse
+			if e.GetType() == sdl.MOUSEMOTION && (e.(*sdl.MouseMotionEvent)).State&sdl.ButtonLMask() != 0 {
+				motion := e.(*sdl.MouseMotionEvent)
+				control = sdl.FPoint{X: float32(motion.X), Y: float32(motion.Y)}
+			}
+		}
+
+		//Show how the shapes are filled
+		mode := "scanlines"
+		if gPrimitives.IsGeometryEnabled() {
+			mode = "RenderGeometry"
+		}
+		newCaption := fmt.Sprintf("SDL Tutorial - Geometry Primitives (%v, thickness %v)", mode, thickness)
+		if newCaption != caption {
+			caption = newCaption
+			gWindow.SetTitle(caption)
+		}
+
+		//Clear screen
+		gRenderer.SetDrawColor(255, 255, 255, 255)
+		gRenderer.Clear()
+
+		if err := renderShapes(float64(sdl.GetTicks())/1000, thickness, control); err != nil {
+			log.Fatal(err)
+		}
+
+		//Update screen
+		gRenderer.Present()
+	}
+
+	//Free resources and close SDL
+	if err := close(); err != nil {
+		log.Fatalf("Could not close SDL! SDL Error: %v\n", err)
+	}
+}
+
+//renderShapes draws a row of circles, a row of polygons and a row of lines and curves
+func renderShapes(seconds float64, thickness float32, control sdl.FPoint) error {
+	//Circles and ellipses
+	gRenderer.SetDrawColor(255, 0, 0, 255)
+	if err := gPrimitives.FillCircle(60, 70, 40); err != nil {
+		return err
+	}
+	gRenderer.SetDrawColor(0, 0, 0, 255)
+	if err := gPrimitives.DrawCircle(60, 70, 40); err != nil {
+		return err
+	}
+	gRenderer.SetDrawColor(0, 160, 0, 255)
+	if err := gPrimitives.FillEllipse(180, 70, 60, 30); err != nil {
+		return err
+	}
+	if err := gPrimitives.DrawEllipse(180, 70, 40, 50); err != nil {
+		return err
+	}
+
+	//Arcs and pies sweeping around
+	sweep := math.Mod(seconds*90, 360)
+	gRenderer.SetDrawColor(0, 0, 255, 255)
+	if err := gPrimitives.FillPie(320, 70, 45, -90, -90+sweep); err != nil {
+		return err
+	}
+	gRenderer.SetDrawColor(0, 0, 0, 255)
+	if err := gPrimitives.DrawArc(320, 70, 50, -90+sweep, 270); err != nil {
+		return err
+	}
+
+	//Overlapping translucent circles only blend once where they cross
+	gRenderer.SetDrawBlendMode(sdl.BLENDMODE_BLEND)
+	gRenderer.SetDrawColor(255, 0, 0, 128)
+	if err := gPrimitives.FillCircle(450, 60, 35); err != nil {
+		return err
+	}
+	gRenderer.SetDrawColor(0, 0, 255, 128)
+	if err := gPrimitives.FillCircle(500, 60, 35); err != nil {
+		return err
+	}
+	gRenderer.SetDrawColor(0, 160, 0, 128)
+	if err := gPrimitives.FillCircle(475, 100, 35); err != nil {
+		return err
+	}
+	gRenderer.SetDrawBlendMode(sdl.BLENDMODE_NONE)
+
+	//Rounded rects
+	gRenderer.SetDrawColor(255, 160, 0, 255)
+	if err := gPrimitives.FillRoundedRect(&sdl.Rect{X: 20, Y: 160, W: 120, H: 80}, 20); err != nil {
+		return err
+	}
+	gRenderer.SetDrawColor(0, 0, 0, 255)
+	if err := gPrimitives.DrawRoundedRect(&sdl.Rect{X: 20, Y: 160, W: 120, H: 80}, 20); err != nil {
+		return err
+	}
+	if err := gPrimitives.DrawRoundedRect(&sdl.Rect{X: 160, Y: 160, W: 80, H: 80}, 40); err != nil {
+		return err
+	}
+
+	//Spinning concave star
+	star := make([]sdl.FPoint, 10)
+	for i := range star {
+		radius := 60.0
+		if i%2 == 1 {
+			radius = 25
+		}
+		angle := seconds*0.5 + float64(i)*math.Pi/5
+		star[i] = sdl.FPoint{X: float32(330 + math.Cos(angle)*radius), Y: float32(200 + math.Sin(angle)*radius)}
+	}
+	gRenderer.SetDrawColor(255, 220, 0, 255)
+	if err := gPrimitives.FillPolygon(star); err != nil {
+		return err
+	}
+	gRenderer.SetDrawColor(0, 0, 0, 255)
+	if err := gPrimitives.DrawPolygon(star, 1); err != nil {
+		return err
+	}
+
+	//Thick outlined arrow
+	arrow := []sdl.FPoint{{X: 450, Y: 180}, {X: 530, Y: 180}, {X: 530, Y: 155}, {X: 600, Y: 200},
+		{X: 530, Y: 245}, {X: 530, Y: 220}, {X: 450, Y: 220}}
+	gRenderer.SetDrawColor(120, 0, 160, 255)
+	if err := gPrimitives.DrawPolygon(arrow, thickness/2); err != nil {
+		return err
+	}
+
+	//Fan of thick lines
+	gRenderer.SetDrawColor(0, 0, 0, 255)
+	for i := 0; i < 5; i++ {
+		angle := math.Pi/2 + float64(i-2)*math.Pi/8
+		x := float32(100 + math.Cos(angle)*-110)
+		y := float32(440 + math.Sin(angle)*-110)
+		if err := gPrimitives.DrawThickLine(100, 440, x, y, thickness); err != nil {
+			return err
+		}
+	}
+
+	//Zigzag with mitered joins
+	zigzag := []sdl.FPoint{{X: 220, Y: 440}, {X: 240, Y: 360}, {X: 260, Y: 440}, {X: 280, Y: 360}}
+	gRenderer.SetDrawColor(0, 120, 200, 255)
+	if err := gPrimitives.DrawPolyline(zigzag, thickness); err != nil {
+		return err
+	}
+
+	//Curves bent toward the control point
+	gRenderer.SetDrawColor(200, 0, 100, 255)
+	if err := gPrimitives.DrawQuadraticBezier(sdl.FPoint{X: 320, Y: 440}, control, sdl.FPoint{X: 440, Y: 440}, thickness); err != nil {
+		return err
+	}
+	gRenderer.SetDrawColor(0, 140, 120, 255)
+	if err := gPrimitives.DrawCubicBezier(sdl.FPoint{X: 460, Y: 440}, sdl.FPoint{X: 460, Y: 300}, control,
+		sdl.FPoint{X: 620, Y: 440}, thickness); err != nil {
+		return err
+	}
+
+	//Control point
+	gRenderer.SetDrawColor(0, 0, 0, 255)
+	return gPrimitives.DrawCircle(int32(control.X), int32(control.Y), 4)
+}
+
+func initSDl() error {
+	//Local error declaration
+	var err error
+
+	//Initialize SDL
+	if err := sdl.Init(sdl.INIT_VIDEO); err != nil {
+		return fmt.Errorf("SDL could not initialize! SDL_ERROR: %v", err)
+	}
+
+	//Set texture filtering to linear
+	if !sdl.SetHint(sdl.HINT_RENDER_SCALE_QUALITY, "1") {
+		fmt.Printf("Warning: Linear texture filtering not enabled!")
+	}
+
+	//Create Window
+	gWindow, err = sdl.CreateWindow("SDL Tutorial", sdl.WINDOWPOS_UNDEFINED, sdl.WINDOWPOS_UNDEFINED,
+		screenWitdh, screenHeight, sdl.WINDOW_SHOWN)
+	if err != nil {
+		return fmt.Errorf("Window could not be created! SDL_Error: %v", err)
+	}
+
+	//Create vsynced renderer for window
+	if gRenderer, err = sdl.CreateRenderer(gWindow, -1, sdl.RENDERER_ACCELERATED|sdl.RENDERER_PRESENTVSYNC); err != nil {
+		return fmt.Errorf("Renderer could not be created! SDL Error: %v", err)
+	}
+
+	//Initialize renderer color
+	gRenderer.SetDrawColor(255, 255, 255, 255)
+
+	//Initialize PNG loading
+	imgFlags := img.INIT_PNG
+	if (img.Init(imgFlags) & imgFlags) == 0 {
+		return fmt.Errorf("SDL_image could not initialize! SDL_image Error: %v", img.GetError())
+	}
+
+	return nil
+}
+
+func loadMedia() error {
+	//Create shape drawer
+	gPrimitives = NewPrimitives(gRenderer)
+
+	return nil
+}
+
+func close() error {
+	//Destroy window
+	if err := gRenderer.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy global renderer: %v", err)
+	}
+	if err := gWindow.Destroy(); err != nil {
+		return fmt.Errorf("Could not destroy window: %v", err)
+	}
+	gWindow = nil
+	gRenderer = nil
+
+	//Quit SDL Subsystems
+	img.Quit()
+	sdl.Quit()
+	return nil
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	//BallSpeed is how fast the balls move in pixels per second
+	BallSpeed = 150
+
+	//Seconds of movement the debug velocity arrow shows
+	ballArrowSeconds = 0.25
+
+	//Seconds the debug collider stays red after a bounce
+	ballBounceSeconds = 0.2
+)
+
+//Ball is a dot with a circle collider bouncing around the level on its own
+type Ball struct {
+	//Center of the ball in the level
+	mPosX, mPosY float64
+
+	//The velocity of the ball in pixels per second
+	mVelX, mVelY float64
+
+	//Ball's collision circle
+	mCollider Circle
+
+	//Seconds left showing the last bounce
+	mBounceTime float64
+}
+
+//NewBall creates a ball at a point heading off at an angle in degrees
+func NewBall(x, y, angle float64) *Ball {
+	ball := &Ball{
+		mPosX:     x,
+		mPosY:     y,
+		mVelX:     math.Cos(angle*math.Pi/180) * BallSpeed,
+		mVelY:     math.Sin(angle*math.Pi/180) * BallSpeed,
+		mCollider: Circle{R: DotWidth / 2},
+	}
+
+	//Move collider to the ball
+	ball.shiftColliders()
+
+	return ball
+}
+
+//Move moves the ball after the given number of seconds, bouncing off the level edges and walls
+func (b *Ball) Move(seconds float64, tiles []*Tile) {
+	b.mBounceTime = math.Max(b.mBounceTime-seconds, 0)
+
+	//Move the ball left or right
+	b.mPosX += b.mVelX * seconds
+	b.shiftColliders()
+
+	//If the ball went too far to the left or right or touched a wall
+	if b.mPosX-float64(b.mCollider.R) < 0 || b.mPosX+float64(b.mCollider.R) > levelWidth || touchesWallCircle(b.mCollider, tiles) {
+		//Move back and bounce
+		b.mPosX -= b.mVelX * seconds
+		b.mVelX = -b.mVelX
+		b.mBounceTime = ballBounceSeconds
+		b.shiftColliders()
+	}
+
+	//Move the ball up or down
+	b.mPosY += b.mVelY * seconds
+	b.shiftColliders()
+
+	//If the ball went too far up or down or touched a wall
+	if b.mPosY-float64(b.mCollider.R) < 0 || b.mPosY+float64(b.mCollider.R) > levelHeight || touchesWallCircle(b.mCollider, tiles) {
+		//Move back and bounce
+		b.mPosY -= b.mVelY * seconds
+		b.mVelY = -b.mVelY
+		b.mBounceTime = ballBounceSeconds
+		b.shiftColliders()
+	}
+}
+
+//Render shows the ball relative to the camera view
+func (b *Ball) Render(camera *Camera2D) error {
+	view := camera.View()
+	err := gDotTexture.Render(b.mCollider.X-b.mCollider.R-view.X, b.mCollider.Y-b.mCollider.R-view.Y, nil, 0, nil, sdl.FLIP_NONE)
+	if err != nil {
+		return fmt.Errorf("could not render ball: %v", err)
+	}
+
+	return nil
+}
+
+//DebugDraw shows the collider, red right after a bounce, and where the ball is heading
+func (b *Ball) DebugDraw(overlay *DebugOverlay) error {
+	color := sdl.Color{R: 0, G: 255, B: 255, A: 255}
+	if b.mBounceTime > 0 {
+		color = sdl.Color{R: 255, G: 0, B: 0, A: 255}
+	}
+	if err := overlay.DrawCircle(b.mCollider, color); err != nil {
+		return err
+	}
+
+	return overlay.DrawArrow(b.mPosX, b.mPosY, b.mVelX*ballArrowSeconds, b.mVelY*ballArrowSeconds,
+		sdl.Color{R: 255, G: 255, B: 0, A: 255})
+}
+
+//shiftColliders moves the collision circle to the ball's position
+func (b *Ball) shiftColliders() {
+	b.mCollider.X = int32(math.Round(b.mPosX))
+	b.mCollider.Y = int32(math.Round(b.mPosY))
+}
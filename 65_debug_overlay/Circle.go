@@ -0,0 +1,7 @@
+package main
+
+//A Circle struct
+type Circle struct {
+	X, Y int32
+	R    int32
+}
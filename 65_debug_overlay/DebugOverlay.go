@@ -0,0 +1,355 @@
+package main
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	//Frames shown in the frame time graph
+	debugGraphFrames = 120
+
+	//Frame time at the top of the graph and the frame time the graph marks, in milliseconds
+	debugGraphMaxMs    = 50
+	debugGraphTargetMs = 1000.0 / 60
+
+	//Size of the frame time graph
+	debugGraphHeight = 50
+
+	//Seconds between updates of the stats text, so it can be read
+	debugStatsInterval = 0.25
+
+	//Lines of stats text
+	debugStatsLines = 4
+
+	//Size of the arrow heads in screen pixels
+	debugArrowHead = 6
+)
+
+//DebugDrawer is a component that shows itself on the debug overlay
+type DebugDrawer interface {
+	//DebugDraw draws the component with the overlay's helpers
+	DebugDraw(overlay *DebugOverlay) error
+}
+
+//DebugOverlay draws registered components' debug shapes and a stats panel over the scene
+//Shapes are given in world coordinates and drawn one screen pixel wide whatever the zoom
+type DebugOverlay struct {
+	//Shape drawer
+	mPrimitives *Primitives
+
+	//Whether the overlay is shown
+	mEnabled bool
+
+	//Components drawing on the overlay
+	mDrawers []DebugDrawer
+
+	//Camera the shapes are drawn through, set while rendering
+	mCamera *Camera2D
+
+	//Recent frame times in seconds, the newest at mFrameIndex
+	mFrameTimes [debugGraphFrames]float64
+	mFrameIndex int
+
+	//Texture draw calls made by the scene last frame
+	mDrawCalls int
+
+	//Stats text, one texture per line, and how long since it was updated
+	mStatsLines [debugStatsLines]LTexture
+	mStatsTime  float64
+}
+
+//NewDebugOverlay creates a hidden overlay drawing with a renderer
+func NewDebugOverlay(renderer *sdl.Renderer) *DebugOverlay {
+	return &DebugOverlay{mPrimitives: NewPrimitives(renderer), mStatsTime: debugStatsInterval}
+}
+
+//Register adds components to draw on the overlay
+func (o *DebugOverlay) Register(drawers ...DebugDrawer) {
+	o.mDrawers = append(o.mDrawers, drawers...)
+}
+
+//Unregister stops drawing a component
+func (o *DebugOverlay) Unregister(drawer DebugDrawer) {
+	for i := range o.mDrawers {
+		if o.mDrawers[i] == drawer {
+			o.mDrawers = append(o.mDrawers[:i], o.mDrawers[i+1:]...)
+			return
+		}
+	}
+}
+
+//SetEnabled shows or hides the overlay
+func (o *DebugOverlay) SetEnabled(enabled bool) {
+	o.mEnabled = enabled
+}
+
+//IsEnabled checks if the overlay is shown
+func (o *DebugOverlay) IsEnabled() bool {
+	return o.mEnabled
+}
+
+//HandleEvent toggles the overlay on F1
+func (o *DebugOverlay) HandleEvent(e sdl.Event) {
+	if e.GetType() == sdl.KEYDOWN && (e.(*sdl.KeyboardEvent)).Repeat == 0 {
+		if (e.(*sdl.KeyboardEvent)).Keysym.Sym == sdl.K_F1 {
+			o.mEnabled = !o.mEnabled
+		}
+	}
+}
+
+//Update records how long the last frame took
+func (o *DebugOverlay) Update(seconds float64) {
+	o.mFrameIndex = (o.mFrameIndex + 1) % debugGraphFrames
+	o.mFrameTimes[o.mFrameIndex] = seconds
+	o.mStatsTime += seconds
+}
+
+//Render draws the registered components and stats through a camera
+//It has to be called last every frame, even when hidden, to count the scene's draw calls
+func (o *DebugOverlay) Render(camera *Camera2D) error {
+	//Everything drawn up to now is the scene
+	o.mDrawCalls = gTextureStats.DrawCalls
+	defer func() { gTextureStats.DrawCalls = 0 }()
+
+	if !o.mEnabled {
+		return nil
+	}
+
+	//Shapes are drawn in screen pixels
+	if err := gRenderer.SetScale(1, 1); err != nil {
+		return fmt.Errorf("could not reset renderer scale: %v", err)
+	}
+	if err := gRenderer.SetDrawBlendMode(sdl.BLENDMODE_BLEND); err != nil {
+		return fmt.Errorf("could not set draw blend mode: %v", err)
+	}
+	defer gRenderer.SetDrawBlendMode(sdl.BLENDMODE_NONE)
+
+	o.mCamera = camera
+	for _, drawer := range o.mDrawers {
+		if err := drawer.DebugDraw(o); err != nil {
+			return err
+		}
+	}
+	o.mCamera = nil
+
+	return o.renderStats()
+}
+
+//DrawBox outlines a box in the world
+func (o *DebugOverlay) DrawBox(box sdl.Rect, color sdl.Color) error {
+	rect := o.screenRect(box)
+	gRenderer.SetDrawColor(color.R, color.G, color.B, color.A)
+	if err := gRenderer.DrawRect(&rect); err != nil {
+		return fmt.Errorf("could not draw debug box: %v", err)
+	}
+
+	return nil
+}
+
+//FillBox fills a box in the world, use a translucent color to see what's under it
+func (o *DebugOverlay) FillBox(box sdl.Rect, color sdl.Color) error {
+	rect := o.screenRect(box)
+	gRenderer.SetDrawColor(color.R, color.G, color.B, color.A)
+	if err := gRenderer.FillRect(&rect); err != nil {
+		return fmt.Errorf("could not fill debug box: %v", err)
+	}
+
+	return nil
+}
+
+//DrawCircle outlines a circle in the world
+func (o *DebugOverlay) DrawCircle(circle Circle, color sdl.Color) error {
+	x, y := o.mCamera.WorldToScreen(float64(circle.X), float64(circle.Y))
+	radius := float64(circle.R) * o.mCamera.Zoom()
+
+	gRenderer.SetDrawColor(color.R, color.G, color.B, color.A)
+	return o.mPrimitives.DrawCircle(int32(math.Round(x)), int32(math.Round(y)), int32(math.Round(radius)))
+}
+
+//DrawArrow draws an arrow in the world from a point along a vector
+func (o *DebugOverlay) DrawArrow(x, y, dx, dy float64, color sdl.Color) error {
+	startX, startY := o.mCamera.WorldToScreen(x, y)
+	endX, endY := o.mCamera.WorldToScreen(x+dx, y+dy)
+
+	//Nothing to point along
+	length := math.Hypot(endX-startX, endY-startY)
+	if length < 1 {
+		return nil
+	}
+
+	//Head lines go back from the tip at 30 degrees either side
+	backX, backY := (startX-endX)/length*debugArrowHead, (startY-endY)/length*debugArrowHead
+	cos, sin := math.Cos(math.Pi/6), math.Sin(math.Pi/6)
+	points := []sdl.FPoint{
+		{X: float32(endX + backX*cos - backY*sin), Y: float32(endY + backX*sin + backY*cos)},
+		{X: float32(endX), Y: float32(endY)},
+		{X: float32(endX + backX*cos + backY*sin), Y: float32(endY - backX*sin + backY*cos)},
+	}
+
+	gRenderer.SetDrawColor(color.R, color.G, color.B, color.A)
+	if err := gRenderer.DrawLineF(float32(startX), float32(startY), float32(endX), float32(endY)); err != nil {
+		return fmt.Errorf("could not draw debug arrow: %v", err)
+	}
+	if err := gRenderer.DrawLinesF(points); err != nil {
+		return fmt.Errorf("could not draw debug arrow head: %v", err)
+	}
+
+	return nil
+}
+
+//DrawCross marks a point in the world
+func (o *DebugOverlay) DrawCross(x, y float64, color sdl.Color) error {
+	screenX, screenY := o.mCamera.WorldToScreen(x, y)
+	centerX, centerY := int32(math.Round(screenX)), int32(math.Round(screenY))
+
+	gRenderer.SetDrawColor(color.R, color.G, color.B, color.A)
+	if err := gRenderer.DrawLine(centerX-debugArrowHead, centerY, centerX+debugArrowHead, centerY); err != nil {
+		return fmt.Errorf("could not draw debug cross: %v", err)
+	}
+	if err := gRenderer.DrawLine(centerX, centerY-debugArrowHead, centerX, centerY+debugArrowHead); err != nil {
+		return fmt.Errorf("could not draw debug cross: %v", err)
+	}
+
+	return nil
+}
+
+//Camera gets the camera shapes are drawn through
+func (o *DebugOverlay) Camera() *Camera2D {
+	return o.mCamera
+}
+
+//FPS gets the average frame rate over the graph
+func (o *DebugOverlay) FPS() float64 {
+	var total float64
+	frames := 0
+	for _, seconds := range o.mFrameTimes {
+		if seconds > 0 {
+			total += seconds
+			frames++
+		}
+	}
+
+	if total == 0 {
+		return 0
+	}
+	return float64(frames) / total
+}
+
+//DrawCalls gets the texture draw calls the scene made last frame
+func (o *DebugOverlay) DrawCalls() int {
+	return o.mDrawCalls
+}
+
+//screenRect converts a box in the world to screen pixels
+func (o *DebugOverlay) screenRect(box sdl.Rect) sdl.Rect {
+	x1, y1 := o.mCamera.WorldToScreen(float64(box.X), float64(box.Y))
+	x2, y2 := o.mCamera.WorldToScreen(float64(box.X+box.W), float64(box.Y+box.H))
+
+	return sdl.Rect{
+		X: int32(math.Round(x1)),
+		Y: int32(math.Round(y1)),
+		W: int32(math.Round(x2)) - int32(math.Round(x1)),
+		H: int32(math.Round(y2)) - int32(math.Round(y1)),
+	}
+}
+
+//renderStats draws the stats text and frame time graph in the top left corner
+func (o *DebugOverlay) renderStats() error {
+	//Refresh the text every now and then
+	if o.mStatsTime >= debugStatsInterval {
+		o.mStatsTime = 0
+		if err := o.updateStatsText(); err != nil {
+			return err
+		}
+	}
+
+	//Panel behind the text and graph
+	var textHeight int32
+	panelWidth := int32(debugGraphFrames * 2)
+	for i := range o.mStatsLines {
+		textHeight += o.mStatsLines[i].GetHeight()
+		if width := o.mStatsLines[i].GetWidth(); width > panelWidth {
+			panelWidth = width
+		}
+	}
+	panel := sdl.Rect{X: 0, Y: 0, W: panelWidth + 8, H: textHeight + debugGraphHeight + 12}
+	gRenderer.SetDrawColor(0, 0, 0, 160)
+	if err := gRenderer.FillRect(&panel); err != nil {
+		return fmt.Errorf("could not fill stats panel: %v", err)
+	}
+
+	//Text lines
+	y := int32(4)
+	for i := range o.mStatsLines {
+		if err := o.mStatsLines[i].Render(4, y, nil, 0, nil, sdl.FLIP_NONE); err != nil {
+			return err
+		}
+		y += o.mStatsLines[i].GetHeight()
+	}
+
+	//Frame time bars, oldest on the left, colored by how far over the target they are
+	bottom := y + 4 + debugGraphHeight
+	for i := 0; i < debugGraphFrames; i++ {
+		ms := o.mFrameTimes[(o.mFrameIndex+1+i)%debugGraphFrames] * 1000
+		height := int32(math.Min(ms/debugGraphMaxMs, 1) * debugGraphHeight)
+
+		switch {
+		case ms <= debugGraphTargetMs*1.1:
+			gRenderer.SetDrawColor(0, 255, 0, 255)
+			break
+		case ms <= debugGraphTargetMs*2.1:
+			gRenderer.SetDrawColor(255, 255, 0, 255)
+			break
+		default:
+			gRenderer.SetDrawColor(255, 0, 0, 255)
+			break
+		}
+		bar := sdl.Rect{X: 4 + int32(i)*2, Y: bottom - height, W: 2, H: height}
+		if err := gRenderer.FillRect(&bar); err != nil {
+			return fmt.Errorf("could not fill frame time bar: %v", err)
+		}
+	}
+
+	//Target frame time line
+	targetHeight := debugGraphTargetMs / debugGraphMaxMs * debugGraphHeight
+	targetY := bottom - int32(targetHeight)
+	gRenderer.SetDrawColor(255, 255, 255, 128)
+	if err := gRenderer.DrawLine(4, targetY, 4+debugGraphFrames*2, targetY); err != nil {
+		return fmt.Errorf("could not draw frame time target: %v", err)
+	}
+
+	return nil
+}
+
+//updateStatsText renders the stats into the text lines
+func (o *DebugOverlay) updateStatsText() error {
+	lines := [debugStatsLines]string{
+		fmt.Sprintf("FPS: %.1f", o.FPS()),
+		fmt.Sprintf("Frame: %.1f ms", o.mFrameTimes[o.mFrameIndex]*1000),
+		fmt.Sprintf("Draw calls: %d", o.mDrawCalls),
+		fmt.Sprintf("Textures: %d, %.2f MB", gTextureStats.Count, float64(gTextureStats.Bytes)/(1024*1024)),
+	}
+
+	white := sdl.Color{R: 255, G: 255, B: 255, A: 255}
+	for i, line := range lines {
+		if err := o.mStatsLines[i].loadFromRenderedText(line, white); err != nil {
+			return fmt.Errorf("could not render stats text: %v", err)
+		}
+	}
+
+	return nil
+}
+
+//Free frees the stats text
+func (o *DebugOverlay) Free() error {
+	for i := range o.mStatsLines {
+		if err := o.mStatsLines[i].Free(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//Primitives draws circle and ellipse outlines in the renderer's draw color
+//It's the outline part of 64_geometry_primitives' Primitives, the only shapes the overlay needs
+type Primitives struct {
+	//Renderer everything is drawn with
+	mRenderer *sdl.Renderer
+
+	//Scratch space reused between shapes
+	mPoints []sdl.Point
+}
+
+//NewPrimitives creates a shape drawer for a renderer
+func NewPrimitives(renderer *sdl.Renderer) *Primitives {
+	return &Primitives{mRenderer: renderer}
+}
+
+//DrawCircle draws a one pixel circle outline
+func (p *Primitives) DrawCircle(x, y, radius int32) error {
+	return p.DrawEllipse(x, y, radius, radius)
+}
+
+//DrawEllipse draws a one pixel ellipse outline with its radii along the axes
+func (p *Primitives) DrawEllipse(x, y, radiusX, radiusY int32) error {
+	if radiusX < 0 || radiusY < 0 {
+		return nil
+	}
+
+	p.mPoints = p.mPoints[:0]
+	ellipseQuadrant(radiusX, radiusY, func(dx, dy int32) {
+		p.addQuadrantPoints(x, y, x, y, dx, dy)
+	})
+
+	return p.drawPoints()
+}
+
+//addQuadrantPoints adds a quadrant point mirrored around four corner centers
+//Mirrored points landing on the same pixel are only added once so blending stays even
+func (p *Primitives) addQuadrantPoints(left, top, right, bottom, dx, dy int32) {
+	p.mPoints = append(p.mPoints, sdl.Point{X: right + dx, Y: bottom + dy})
+	if left-dx != right+dx {
+		p.mPoints = append(p.mPoints, sdl.Point{X: left - dx, Y: bottom + dy})
+	}
+	if top-dy != bottom+dy {
+		p.mPoints = append(p.mPoints, sdl.Point{X: right + dx, Y: top - dy})
+		if left-dx != right+dx {
+			p.mPoints = append(p.mPoints, sdl.Point{X: left - dx, Y: top - dy})
+		}
+	}
+}
+
+//drawPoints draws the collected points
+func (p *Primitives) drawPoints() error {
+	if len(p.mPoints) == 0 {
+		return nil
+	}
+
+	if err := p.mRenderer.DrawPoints(p.mPoints); err != nil {
+		return fmt.Errorf("could not draw points: %v", err)
+	}
+
+	return nil
+}
+
+//ellipseQuadrant walks the bottom right quarter of an ellipse outline with the midpoint algorithm
+//Every pixel is passed to plot once, starting at the bottom and ending at the right
+func ellipseQuadrant(radiusX, radiusY int32, plot func(dx, dy int32)) {
+	//A flat ellipse is a line
+	if radiusY == 0 {
+		for x := int32(0); x <= radiusX; x++ {
+			plot(x, 0)
+		}
+		return
+	}
+
+	rx2 := float64(radiusX) * float64(radiusX)
+	ry2 := float64(radiusY) * float64(radiusY)
+	x, y := int32(0), radiusY
+
+	//How fast the outline moves in x and y
+	stepX, stepY := 0.0, 2*rx2*float64(y)
+
+	//Flatter than 45 degrees, step along x
+	decision := ry2 - rx2*float64(radiusY) + rx2/4
+	for stepX < stepY {
+		plot(x, y)
+
+		x++
+		stepX += 2 * ry2
+		if decision < 0 {
+			decision += ry2 + stepX
+		} else {
+			y--
+			stepY -= 2 * rx2
+			decision += ry2 + stepX - stepY
+		}
+	}
+
+	//Steeper, step along y
+	midX, midY := float64(x)+0.5, float64(y-1)
+	decision = ry2*midX*midX + rx2*midY*midY - rx2*ry2
+	for y >= 0 {
+		plot(x, y)
+
+		y--
+		stepY -= 2 * rx2
+		if decision > 0 {
+			decision += rx2 - stepY
+		} else {
+			x++
+			stepX += 2 * ry2
+			decision += rx2 - stepY + stepX
+		}
+	}
+}
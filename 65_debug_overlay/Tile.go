@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+//Tile is used to make the map of reusable pieces
+type Tile struct {
+	//The attributes of the tile
+	mBox sdl.Rect
+
+	//The tile type
+	mType int
+}
+
+//NewTile initializes position and type
+func NewTile(x, y int32, tileType int) *Tile {
+	return &Tile{
+		//Get the offsets and set the collision box
+		mBox: sdl.Rect{X: x, Y: y, W: tileWidth, H: tileHeight},
+		//Get the tile type
+		mType: tileType,
+	}
+}
+
+//Render show the tile
+func (t *Tile) Render(camera *Camera2D) error {
+	//If the tile is on the screen
+	view := camera.View()
+	if checkCollision(view, t.mBox) {
+		//Show the tile
+		err := gTileTexture.Render(t.mBox.X-view.X, t.mBox.Y-view.Y,
+			&gTileClips[t.mType], 0, nil, sdl.FLIP_NONE)
+		if err != nil {
+			return fmt.Errorf("could not render tile's texture: %v", err)
+		}
+	}
+
+	return nil
+}
+
+//MType exports the file type
+func (t *Tile) MType() int {
+	return t.mType
+}
+
+//MBox exports the collision box
+func (t *Tile) MBox() sdl.Rect {
+	return t.mBox
+}
+
+//DebugDraw outlines the tile and shades it if it's a wall
+func (t *Tile) DebugDraw(overlay *DebugOverlay) error {
+	if t.mType >= tileCenter && t.mType <= tileTopLeft {
+		if err := overlay.FillBox(t.mBox, sdl.Color{R: 255, G: 0, B: 0, A: 64}); err != nil {
+			return err
+		}
+	}
+
+	return overlay.DrawBox(t.mBox, sdl.Color{R: 255, G: 255, B: 255, A: 96})
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+//CVarKind is the type of value a cvar holds
+type CVarKind int
+
+//Kinds of cvars
+const (
+	cvarInt CVarKind = iota
+	cvarFloat
+	cvarBool
+	cvarString
+)
+
+//String gets the kind's name
+func (k CVarKind) String() string {
+	switch k {
+	case cvarInt:
+		return "int"
+	case cvarFloat:
+		return "float"
+	case cvarBool:
+		return "bool"
+	case cvarString:
+		return "string"
+	}
+
+	return fmt.Sprintf("CVarKind(%d)", int(k))
+}
+
+//CVar is a console variable, code reads it at runtime and the console sets it from text
+type CVar struct {
+	mName string
+	mHelp string
+	mKind CVarKind
+
+	//Current and starting value as text
+	mValue   string
+	mDefault string
+
+	//Current value parsed, numbers fill both
+	mInt   int
+	mFloat float64
+	mBool  bool
+
+	//Range numbers have to be in
+	mMin float64
+	mMax float64
+}
+
+//newCVar creates a cvar starting at a value
+func newCVar(name string, kind CVarKind, value, help string, min, max float64) (*CVar, error) {
+	cvar := &CVar{mName: name, mHelp: help, mKind: kind, mMin: min, mMax: max}
+	if err := cvar.Set(value); err != nil {
+		return nil, err
+	}
+	cvar.mDefault = cvar.mValue
+
+	return cvar, nil
+}
+
+//Set parses and sets a new value
+func (c *CVar) Set(value string) error {
+	switch c.mKind {
+	case cvarInt:
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%v needs a whole number, got %q", c.mName, value)
+		}
+		if err := c.checkRange(float64(number)); err != nil {
+			return err
+		}
+		c.mInt, c.mFloat = number, float64(number)
+		c.mValue = strconv.Itoa(number)
+		break
+
+	case cvarFloat:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+			return fmt.Errorf("%v needs a number, got %q", c.mName, value)
+		}
+		if err := c.checkRange(number); err != nil {
+			return err
+		}
+		c.mInt, c.mFloat = int(number), number
+		c.mValue = strconv.FormatFloat(number, 'g', -1, 64)
+		break
+
+	case cvarBool:
+		switch strings.ToLower(value) {
+		case "1", "true", "on", "yes":
+			c.mBool = true
+			break
+		case "0", "false", "off", "no":
+			c.mBool = false
+			break
+		default:
+			return fmt.Errorf("%v needs on or off, got %q", c.mName, value)
+		}
+		c.mValue = strconv.FormatBool(c.mBool)
+		break
+
+	case cvarString:
+		c.mValue = value
+		break
+	}
+
+	return nil
+}
+
+//Reset sets the cvar back to its starting value
+func (c *CVar) Reset() {
+	c.Set(c.mDefault)
+}
+
+//Name gets the cvar's name
+func (c *CVar) Name() string {
+	return c.mName
+}
+
+//Kind gets the type of value the cvar holds
+func (c *CVar) Kind() CVarKind {
+	return c.mKind
+}
+
+//Int gets the value of a number cvar, floats are cut down to whole numbers
+func (c *CVar) Int() int {
+	return c.mInt
+}
+
+//Float gets the value of a number cvar
+func (c *CVar) Float() float64 {
+	return c.mFloat
+}
+
+//Bool gets the value of a bool cvar
+func (c *CVar) Bool() bool {
+	return c.mBool
+}
+
+//String gets the value as text
+func (c *CVar) String() string {
+	return c.mValue
+}
+
+//Describe gets the cvar's value, kind, default and help on one line
+func (c *CVar) Describe() string {
+	description := fmt.Sprintf("%v = %q (%v", c.mName, c.mValue, c.mKind)
+	if c.mKind == cvarInt || c.mKind == cvarFloat {
+		description += fmt.Sprintf(" %v to %v", c.mMin, c.mMax)
+	}
+
+	return description + fmt.Sprintf(", default %q) %v", c.mDefault, c.mHelp)
+}
+
+//checkRange makes sure a number is within the cvar's range
+func (c *CVar) checkRange(number float64) error {
+	if number < c.mMin || number > c.mMax {
+		return fmt.Errorf("%v has to be from %v to %v, got %v", c.mName, c.mMin, c.mMax, number)
+	}
+
+	return nil
+}
@@ -0,0 +1,854 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	//Lines of output kept for scrolling back
+	consoleMaxLines = 256
+
+	//Entered lines kept in the history
+	consoleMaxHistory = 64
+
+	//How deep scripts can exec other scripts
+	consoleMaxExecDepth = 8
+
+	//Pixels between the text and the console's edges
+	consolePadding = 4
+
+	//Lines moved by page up and page down
+	consolePageLines = 8
+
+	//What's shown before the typed line
+	consolePrompt = "> "
+)
+
+//Console text colors
+var (
+	consoleTextColor  = sdl.Color{R: 255, G: 255, B: 255, A: 255}
+	consoleEchoColor  = sdl.Color{R: 160, G: 160, B: 160, A: 255}
+	consoleErrorColor = sdl.Color{R: 255, G: 96, B: 96, A: 255}
+)
+
+//CommandFunc runs a console command with the words typed after its name
+type CommandFunc func(console *Console, args []string) error
+
+//consoleCommand is a registered command
+type consoleCommand struct {
+	mName string
+	mHelp string
+	mRun  CommandFunc
+}
+
+//consoleLine is a line of output, its texture is rendered the first time it's shown
+type consoleLine struct {
+	mText     string
+	mColor    sdl.Color
+	mTexture  LTexture
+	mRendered bool
+}
+
+//Console drops down from the top of the screen when backtick is pressed
+//Typed lines run registered commands or set cvars, which code reads while running
+type Console struct {
+	//Registered commands and cvars by name
+	mCommands map[string]*consoleCommand
+	mCVars    map[string]*CVar
+
+	//Output, oldest first, and how many lines it's scrolled back from the newest
+	mLines  []*consoleLine
+	mScroll int
+
+	//Typed line, the cursor's byte offset in it and its texture
+	mInput        string
+	mCursor       int
+	mInputTexture LTexture
+	mInputChanged bool
+
+	//Entered lines, oldest first, the one being browsed and what was typed before browsing
+	mHistory      []string
+	mHistoryIndex int
+	mDraft        string
+
+	//Whether the console is open and how far down it is from 0 to 1
+	mOpen  bool
+	mSlide float64
+
+	//Scripts being run inside each other
+	mExecDepth int
+
+	//Console settings
+	mHeight *CVar
+	mSpeed  *CVar
+}
+
+//NewConsole creates a closed console with the built in commands
+func NewConsole() (*Console, error) {
+	c := &Console{
+		mCommands:     make(map[string]*consoleCommand),
+		mCVars:        make(map[string]*CVar),
+		mInputChanged: true,
+	}
+
+	//Built in commands
+	commands := []consoleCommand{
+		{"help", "lists commands, or describes the command or cvar named", consoleHelp},
+		{"cvars", "lists cvars and their values", consoleListCVars},
+		{"echo", "prints its arguments", consoleEcho},
+		{"clear", "clears the output", consoleClear},
+		{"history", "lists entered lines", consoleHistory},
+		{"exec", "runs every line of a script file", consoleExec},
+		{"reset", "sets cvars back to their defaults", consoleReset},
+		{"toggle", "flips a bool cvar", consoleToggle},
+	}
+	for i := range commands {
+		if err := c.RegisterCommand(commands[i].mName, commands[i].mHelp, commands[i].mRun); err != nil {
+			return nil, err
+		}
+	}
+
+	//Console settings
+	var err error
+	if c.mHeight, err = c.RegisterFloat("con_height", 0.5, 0.1, 1, "part of the screen the console covers"); err != nil {
+		return nil, err
+	}
+	if c.mSpeed, err = c.RegisterFloat("con_speed", 4, 0, 100, "console slides per second, 0 is instant"); err != nil {
+		return nil, err
+	}
+
+	//Typing only goes to the console while it's open
+	c.SetOpen(false)
+
+	return c, nil
+}
+
+//RegisterCommand adds a command that runs when its name is entered
+func (c *Console) RegisterCommand(name, help string, run CommandFunc) error {
+	if err := c.checkName(name); err != nil {
+		return err
+	}
+
+	c.mCommands[name] = &consoleCommand{mName: name, mHelp: help, mRun: run}
+	return nil
+}
+
+//RegisterInt adds a whole number cvar limited to a range
+func (c *Console) RegisterInt(name string, value, min, max int, help string) (*CVar, error) {
+	return c.registerCVar(name, cvarInt, fmt.Sprint(value), help, float64(min), float64(max))
+}
+
+//RegisterFloat adds a number cvar limited to a range
+func (c *Console) RegisterFloat(name string, value, min, max float64, help string) (*CVar, error) {
+	return c.registerCVar(name, cvarFloat, fmt.Sprint(value), help, min, max)
+}
+
+//RegisterBool adds an on or off cvar
+func (c *Console) RegisterBool(name string, value bool, help string) (*CVar, error) {
+	return c.registerCVar(name, cvarBool, fmt.Sprint(value), help, 0, 0)
+}
+
+//RegisterString adds a text cvar
+func (c *Console) RegisterString(name, value, help string) (*CVar, error) {
+	return c.registerCVar(name, cvarString, value, help, 0, 0)
+}
+
+//CVar gets a registered cvar, nil if there is none by that name
+func (c *Console) CVar(name string) *CVar {
+	return c.mCVars[name]
+}
+
+//Printf adds formatted output, a line per line of text
+func (c *Console) Printf(format string, args ...interface{}) {
+	c.print(fmt.Sprintf(format, args...), consoleTextColor)
+}
+
+//Execute runs a line of commands split by semicolons, stopping at the first error
+//A cvar's name on its own prints it and followed by a value sets it
+func (c *Console) Execute(line string) error {
+	statements, err := splitStatements(line)
+	if err != nil {
+		return err
+	}
+
+	for _, args := range statements {
+		name := strings.ToLower(args[0])
+
+		//Run command
+		if command, ok := c.mCommands[name]; ok {
+			if err := command.mRun(c, args[1:]); err != nil {
+				return fmt.Errorf("%v: %v", name, err)
+			}
+			continue
+		}
+
+		//Print or set cvar
+		if cvar, ok := c.mCVars[name]; ok {
+			if len(args) == 1 {
+				c.Printf("%v", cvar.Describe())
+			} else if err := cvar.Set(strings.Join(args[1:], " ")); err != nil {
+				return err
+			}
+			continue
+		}
+
+		return fmt.Errorf("unknown command or cvar %q", args[0])
+	}
+
+	return nil
+}
+
+//Exec runs every line of a script, lines starting with // or # are comments
+//Errors are printed and the script carries on
+func (c *Console) Exec(path string) error {
+	if c.mExecDepth >= consoleMaxExecDepth {
+		return fmt.Errorf("scripts can only exec %d deep", consoleMaxExecDepth)
+	}
+
+	script, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("could not open script: %v", err)
+	}
+	defer script.Close()
+
+	c.mExecDepth++
+	defer func() { c.mExecDepth-- }()
+
+	scanner := bufio.NewScanner(script)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "//") || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if err := c.Execute(line); err != nil {
+			c.printError(fmt.Errorf("%v:%d: %v", path, lineNumber, err))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("could not read script: %v", err)
+	}
+
+	return nil
+}
+
+//SetOpen opens or closes the console
+func (c *Console) SetOpen(open bool) {
+	c.mOpen = open
+
+	//Only ask for text while typing
+	if open {
+		sdl.StartTextInput()
+	} else {
+		sdl.StopTextInput()
+	}
+}
+
+//IsOpen checks if the console is open or opening
+func (c *Console) IsOpen() bool {
+	return c.mOpen
+}
+
+//HandleEvent toggles the console on backtick and takes keys while it's open
+//It returns true if the event was used up by the console
+func (c *Console) HandleEvent(e sdl.Event) bool {
+	switch e.GetType() {
+	case sdl.KEYDOWN:
+		k := e.(*sdl.KeyboardEvent)
+
+		//Backtick toggles the console from anywhere
+		if k.Keysym.Sym == sdl.K_BACKQUOTE {
+			if k.Repeat == 0 {
+				c.SetOpen(!c.mOpen)
+			}
+			return true
+		}
+
+		if !c.mOpen {
+			return false
+		}
+		c.handleKey(k)
+		return true
+
+	case sdl.TEXTINPUT:
+		if !c.mOpen {
+			return false
+		}
+
+		//The backtick that opened the console comes through as text too
+		c.insert(strings.Replace((e.(*sdl.TextInputEvent)).GetText(), "`", "", -1))
+		return true
+
+	case sdl.MOUSEWHEEL:
+		if !c.mOpen {
+			return false
+		}
+		c.scroll(int((e.(*sdl.MouseWheelEvent)).Y))
+		return true
+
+	case sdl.KEYUP, sdl.TEXTEDITING:
+		return c.mOpen
+	}
+
+	return false
+}
+
+//Update slides the console after the given number of seconds
+func (c *Console) Update(seconds float64) {
+	target := 0.0
+	if c.mOpen {
+		target = 1
+	}
+
+	//Instant
+	if c.mSpeed.Float() == 0 {
+		c.mSlide = target
+		return
+	}
+
+	step := c.mSpeed.Float() * seconds
+	if c.mSlide < target {
+		c.mSlide = math.Min(c.mSlide+step, target)
+	} else {
+		c.mSlide = math.Max(c.mSlide-step, target)
+	}
+}
+
+//Render draws the console over the top of the screen
+func (c *Console) Render() error {
+	if c.mSlide <= 0 {
+		return nil
+	}
+
+	//Slide down from above the screen
+	height := int32(c.mHeight.Float() * screenHeight)
+	bottom := int32(float64(height) * c.mSlide)
+
+	//Background
+	gRenderer.SetDrawBlendMode(sdl.BLENDMODE_BLEND)
+	gRenderer.SetDrawColor(0, 0, 0, 208)
+	if err := gRenderer.FillRect(&sdl.Rect{X: 0, Y: 0, W: screenWitdh, H: bottom}); err != nil {
+		return fmt.Errorf("could not fill console background: %v", err)
+	}
+	gRenderer.SetDrawColor(255, 255, 255, 255)
+	if err := gRenderer.DrawLine(0, bottom, screenWitdh, bottom); err != nil {
+		return fmt.Errorf("could not draw console edge: %v", err)
+	}
+	gRenderer.SetDrawBlendMode(sdl.BLENDMODE_NONE)
+
+	//Rerender the typed line if it changed
+	if c.mInputChanged {
+		if err := c.mInputTexture.loadFromRenderedText(consolePrompt+c.mInput, consoleTextColor); err != nil {
+			return err
+		}
+		c.mInputChanged = false
+	}
+
+	//Typed line along the bottom
+	lineHeight := int32(gFont.Height())
+	y := bottom - consolePadding - lineHeight
+	if err := c.mInputTexture.Render(consolePadding, y, nil, 0, nil, sdl.FLIP_NONE); err != nil {
+		return err
+	}
+
+	//Blinking cursor
+	if sdl.GetTicks()/500%2 == 0 {
+		width, _, err := gFont.SizeUTF8(consolePrompt + c.mInput[:c.mCursor])
+		if err != nil {
+			return fmt.Errorf("could not measure console input: %v", err)
+		}
+		x := consolePadding + int32(width)
+		if err := gRenderer.DrawLine(x, y, x, y+lineHeight-1); err != nil {
+			return fmt.Errorf("could not draw console cursor: %v", err)
+		}
+	}
+
+	//Output going up from the typed line, newest first
+	for i := len(c.mLines) - 1 - c.mScroll; i >= 0 && y > 0; i-- {
+		y -= lineHeight
+		line := c.mLines[i]
+		if !line.mRendered {
+			//SDL_ttf can't render empty strings
+			text := line.mText
+			if text == "" {
+				text = " "
+			}
+			if err := line.mTexture.loadFromRenderedText(text, line.mColor); err != nil {
+				return err
+			}
+			line.mRendered = true
+		}
+		if err := line.mTexture.Render(consolePadding, y, nil, 0, nil, sdl.FLIP_NONE); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//Free frees the text textures
+func (c *Console) Free() error {
+	if err := c.mInputTexture.Free(); err != nil {
+		return err
+	}
+
+	return c.clear()
+}
+
+//handleKey edits the typed line and runs it on enter
+func (c *Console) handleKey(k *sdl.KeyboardEvent) {
+	ctrl := sdl.GetModState()&sdl.KMOD_CTRL != 0
+
+	switch k.Keysym.Sym {
+	//Run the line
+	case sdl.K_RETURN, sdl.K_KP_ENTER:
+		c.submit()
+		break
+
+	//Close
+	case sdl.K_ESCAPE:
+		c.SetOpen(false)
+		break
+
+	//Delete before and after the cursor
+	case sdl.K_BACKSPACE:
+		if c.mCursor > 0 {
+			_, size := utf8.DecodeLastRuneInString(c.mInput[:c.mCursor])
+			c.setInput(c.mInput[:c.mCursor-size]+c.mInput[c.mCursor:], c.mCursor-size)
+		}
+		break
+	case sdl.K_DELETE:
+		if c.mCursor < len(c.mInput) {
+			_, size := utf8.DecodeRuneInString(c.mInput[c.mCursor:])
+			c.setInput(c.mInput[:c.mCursor]+c.mInput[c.mCursor+size:], c.mCursor)
+		}
+		break
+
+	//Move the cursor
+	case sdl.K_LEFT:
+		if c.mCursor > 0 {
+			_, size := utf8.DecodeLastRuneInString(c.mInput[:c.mCursor])
+			c.mCursor -= size
+		}
+		break
+	case sdl.K_RIGHT:
+		if c.mCursor < len(c.mInput) {
+			_, size := utf8.DecodeRuneInString(c.mInput[c.mCursor:])
+			c.mCursor += size
+		}
+		break
+	case sdl.K_HOME:
+		c.mCursor = 0
+		break
+	case sdl.K_END:
+		c.mCursor = len(c.mInput)
+		break
+
+	//Browse the history
+	case sdl.K_UP:
+		c.browseHistory(-1)
+		break
+	case sdl.K_DOWN:
+		c.browseHistory(1)
+		break
+
+	//Scroll the output
+	case sdl.K_PAGEUP:
+		c.scroll(consolePageLines)
+		break
+	case sdl.K_PAGEDOWN:
+		c.scroll(-consolePageLines)
+		break
+
+	//Complete names
+	case sdl.K_TAB:
+		c.complete()
+		break
+
+	//Copy, paste and clear
+	case sdl.K_c:
+		if ctrl {
+			if err := sdl.SetClipboardText(c.mInput); err != nil {
+				c.printError(fmt.Errorf("could not set clipboard text: %v", err))
+			}
+		}
+		break
+	case sdl.K_v:
+		if ctrl {
+			text, err := sdl.GetClipboardText()
+			if err != nil {
+				c.printError(fmt.Errorf("could not get clipboard text: %v", err))
+				break
+			}
+			c.insert(strings.Map(func(r rune) rune {
+				if unicode.IsControl(r) {
+					return ' '
+				}
+				return r
+			}, text))
+		}
+		break
+	case sdl.K_l:
+		if ctrl {
+			c.clear()
+		}
+		break
+	}
+}
+
+//submit runs the typed line
+func (c *Console) submit() {
+	line := c.mInput
+	c.setInput("", 0)
+	c.mScroll = 0
+	c.print(consolePrompt+line, consoleEchoColor)
+
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+
+	//Remember the line unless it was just entered
+	if len(c.mHistory) == 0 || c.mHistory[len(c.mHistory)-1] != line {
+		c.mHistory = append(c.mHistory, line)
+		if len(c.mHistory) > consoleMaxHistory {
+			c.mHistory = c.mHistory[1:]
+		}
+	}
+	c.mHistoryIndex = len(c.mHistory)
+
+	if err := c.Execute(line); err != nil {
+		c.printError(err)
+	}
+}
+
+//browseHistory steps through entered lines, back is negative
+func (c *Console) browseHistory(step int) {
+	index := c.mHistoryIndex + step
+	if index < 0 || index > len(c.mHistory) {
+		return
+	}
+
+	//Keep what was being typed to come back to it
+	if c.mHistoryIndex == len(c.mHistory) {
+		c.mDraft = c.mInput
+	}
+
+	c.mHistoryIndex = index
+	if index == len(c.mHistory) {
+		c.setInput(c.mDraft, len(c.mDraft))
+	} else {
+		c.setInput(c.mHistory[index], len(c.mHistory[index]))
+	}
+}
+
+//complete fills in the name being typed before the cursor
+//If more than one name fits, it fills in what they share and lists them
+func (c *Console) complete() {
+	start := strings.LastIndexAny(c.mInput[:c.mCursor], " ;") + 1
+	prefix := strings.ToLower(c.mInput[start:c.mCursor])
+
+	var matches []string
+	for _, name := range c.names() {
+		if strings.HasPrefix(name, prefix) {
+			matches = append(matches, name)
+		}
+	}
+	if len(matches) == 0 {
+		return
+	}
+
+	//What all the matches start with
+	completion := matches[0]
+	for _, match := range matches[1:] {
+		for !strings.HasPrefix(match, completion) {
+			completion = completion[:len(completion)-1]
+		}
+	}
+
+	//A single match is finished with a space
+	if len(matches) == 1 {
+		completion += " "
+	} else if completion == prefix {
+		c.Printf("%v", strings.Join(matches, "  "))
+	}
+
+	c.setInput(c.mInput[:start]+completion+c.mInput[c.mCursor:], start+len(completion))
+}
+
+//insert types text at the cursor
+func (c *Console) insert(text string) {
+	if text == "" {
+		return
+	}
+
+	c.setInput(c.mInput[:c.mCursor]+text+c.mInput[c.mCursor:], c.mCursor+len(text))
+}
+
+//setInput changes the typed line and puts the cursor at a byte offset
+func (c *Console) setInput(input string, cursor int) {
+	c.mInput = input
+	c.mCursor = cursor
+	c.mInputChanged = true
+}
+
+//scroll moves back through the output, forward is negative
+func (c *Console) scroll(lines int) {
+	c.mScroll += lines
+	if c.mScroll > len(c.mLines)-1 {
+		c.mScroll = len(c.mLines) - 1
+	}
+	if c.mScroll < 0 {
+		c.mScroll = 0
+	}
+}
+
+//print adds output in a color, dropping the oldest lines once there are too many
+func (c *Console) print(text string, color sdl.Color) {
+	for _, line := range strings.Split(text, "\n") {
+		c.mLines = append(c.mLines, &consoleLine{mText: line, mColor: color})
+
+		//Keep the view still while scrolled back
+		if c.mScroll > 0 {
+			c.mScroll++
+		}
+	}
+
+	for len(c.mLines) > consoleMaxLines {
+		c.mLines[0].mTexture.Free()
+		c.mLines = c.mLines[1:]
+	}
+	c.scroll(0)
+}
+
+//printError adds an error to the output
+func (c *Console) printError(err error) {
+	c.print(err.Error(), consoleErrorColor)
+}
+
+//clear removes all output
+func (c *Console) clear() error {
+	for _, line := range c.mLines {
+		if err := line.mTexture.Free(); err != nil {
+			return err
+		}
+	}
+	c.mLines = nil
+	c.mScroll = 0
+
+	return nil
+}
+
+//names gets every command and cvar name in order
+func (c *Console) names() []string {
+	names := make([]string, 0, len(c.mCommands)+len(c.mCVars))
+	for name := range c.mCommands {
+		names = append(names, name)
+	}
+	for name := range c.mCVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+//checkName makes sure a new command or cvar name is usable and free
+func (c *Console) checkName(name string) error {
+	if name == "" || name != strings.ToLower(name) || strings.ContainsAny(name, " \t;\"") {
+		return fmt.Errorf("console name %q has to be lower case without spaces, semicolons or quotes", name)
+	}
+	if _, ok := c.mCommands[name]; ok {
+		return fmt.Errorf("console already has a command named %v", name)
+	}
+	if _, ok := c.mCVars[name]; ok {
+		return fmt.Errorf("console already has a cvar named %v", name)
+	}
+
+	return nil
+}
+
+//registerCVar adds a cvar of any kind
+func (c *Console) registerCVar(name string, kind CVarKind, value, help string, min, max float64) (*CVar, error) {
+	if err := c.checkName(name); err != nil {
+		return nil, err
+	}
+
+	cvar, err := newCVar(name, kind, value, help, min, max)
+	if err != nil {
+		return nil, fmt.Errorf("could not register cvar: %v", err)
+	}
+
+	c.mCVars[name] = cvar
+	return cvar, nil
+}
+
+//splitStatements splits a line into statements at semicolons and statements into words at spaces
+//Double quotes keep spaces and semicolons in a word
+func splitStatements(line string) ([][]string, error) {
+	var statements [][]string
+	var words []string
+	var word strings.Builder
+	inWord, quoted := false, false
+
+	//Finish the word being read
+	endWord := func() {
+		if inWord {
+			words = append(words, word.String())
+			word.Reset()
+			inWord = false
+		}
+	}
+
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inWord = true
+			break
+		case quoted:
+			word.WriteRune(r)
+			break
+		case r == ';':
+			endWord()
+			if len(words) > 0 {
+				statements = append(statements, words)
+			}
+			words = nil
+			break
+		case unicode.IsSpace(r):
+			endWord()
+			break
+		default:
+			word.WriteRune(r)
+			inWord = true
+			break
+		}
+	}
+
+	if quoted {
+		return nil, fmt.Errorf("missing closing quote")
+	}
+	endWord()
+	if len(words) > 0 {
+		statements = append(statements, words)
+	}
+
+	return statements, nil
+}
+
+//consoleHelp lists the commands or describes one command or cvar
+func consoleHelp(c *Console, args []string) error {
+	if len(args) == 0 {
+		for _, name := range c.names() {
+			if command, ok := c.mCommands[name]; ok {
+				c.Printf("%v - %v", name, command.mHelp)
+			}
+		}
+		c.Printf("Type a cvar's name to see it or its name and a value to set it")
+		return nil
+	}
+
+	for _, name := range args {
+		if command, ok := c.mCommands[name]; ok {
+			c.Printf("%v - %v", name, command.mHelp)
+		} else if cvar, ok := c.mCVars[name]; ok {
+			c.Printf("%v", cvar.Describe())
+		} else {
+			return fmt.Errorf("no command or cvar named %q", name)
+		}
+	}
+
+	return nil
+}
+
+//consoleListCVars lists the cvars
+func consoleListCVars(c *Console, args []string) error {
+	for _, name := range c.names() {
+		if cvar, ok := c.mCVars[name]; ok {
+			c.Printf("%v", cvar.Describe())
+		}
+	}
+
+	return nil
+}
+
+//consoleEcho prints its arguments
+func consoleEcho(c *Console, args []string) error {
+	c.Printf("%v", strings.Join(args, " "))
+	return nil
+}
+
+//consoleClear clears the output
+func consoleClear(c *Console, args []string) error {
+	return c.clear()
+}
+
+//consoleHistory lists the entered lines
+func consoleHistory(c *Console, args []string) error {
+	for i, line := range c.mHistory {
+		c.Printf("%3d  %v", i+1, line)
+	}
+
+	return nil
+}
+
+//consoleExec runs script files
+func consoleExec(c *Console, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("needs a script file")
+	}
+
+	for _, path := range args {
+		if err := c.Exec(path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//consoleReset sets the named cvars, or all of them, back to their defaults
+func consoleReset(c *Console, args []string) error {
+	if len(args) == 0 {
+		for _, cvar := range c.mCVars {
+			cvar.Reset()
+		}
+		return nil
+	}
+
+	for _, name := range args {
+		cvar, ok := c.mCVars[name]
+		if !ok {
+			return fmt.Errorf("no cvar named %q", name)
+		}
+		cvar.Reset()
+	}
+
+	return nil
+}
+
+//consoleToggle flips bool cvars
+func consoleToggle(c *Console, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("needs a bool cvar")
+	}
+
+	for _, name := range args {
+		cvar, ok := c.mCVars[name]
+		if !ok || cvar.Kind() != cvarBool {
+			return fmt.Errorf("no bool cvar named %q", name)
+		}
+		cvar.Set(fmt.Sprint(!cvar.Bool()))
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+)
+
+const (
+	//DotWidth is the dot's width
+	DotWidth = 20
+	//DotHeight is the dot's height
+	DotHeight = 20
+
+	//DotVel is the starting maximum axis velocity of the dot
+	DotVel = 10
+)
+
+//Dot is the dot that will move around on the screen
+type Dot struct {
+	//The particles
+	particles []*Particle
+
+	//The X and Y offsets of the dot
+	mPosX int32
+	mPosY int32
+
+	//The velocity of the dot
+	mVelX int32
+	mVelY int32
+}
+
+//NewDot allocates particles
+func NewDot() *Dot {
+	d := &Dot{}
+
+	//Initialize particles
+	d.SetParticleCount(TotalParticles)
+
+	return d
+}
+
+//SetParticleCount adds or removes particles to have the given number
+func (d *Dot) SetParticleCount(count int) {
+	if count < len(d.particles) {
+		d.particles = d.particles[:count]
+	}
+	for len(d.particles) < count {
+		d.particles = append(d.particles, NewParticle(d.mPosX, d.mPosY))
+	}
+}
+
+//SetPosition moves the dot to a point on the screen
+func (d *Dot) SetPosition(x, y int32) {
+	d.mPosX = x
+	d.mPosY = y
+}
+
+//HandleKeys sets the dot's velocity from the arrow keys being held
+//Reading the keyboard state rather than events keeps the dot from drifting when the console takes a key release
+func (d *Dot) HandleKeys(vel int32) {
+	currentKeyStates := sdl.GetKeyboardState()
+
+	//Adjust the velocity
+	d.mVelX, d.mVelY = 0, 0
+	if currentKeyStates[sdl.SCANCODE_UP] != 0 {
+		d.mVelY -= vel
+	}
+	if currentKeyStates[sdl.SCANCODE_DOWN] != 0 {
+		d.mVelY += vel
+	}
+	if currentKeyStates[sdl.SCANCODE_LEFT] != 0 {
+		d.mVelX -= vel
+	}
+	if currentKeyStates[sdl.SCANCODE_RIGHT] != 0 {
+		d.mVelX += vel
+	}
+}
+
+//Move moves the dot
+func (d *Dot) Move() {
+	//Move the dot left or right
+	d.mPosX += d.mVelX
+
+	//If the dot went too far to the left or right
+	if d.mPosX < 0 || d.mPosX+DotWidth > screenWitdh {
+		//Move back
+		d.mPosX -= d.mVelX
+	}
+
+	//Move the dot up or down
+	d.mPosY += d.mVelY
+
+	//If the dot went too far up or down
+	if d.mPosY < 0 || d.mPosY+DotHeight > screenHeight {
+		//Move back
+		d.mPosY -= d.mVelY
+	}
+}
+
+//Render shows the dot on the screen with shimmering particles if asked
+func (d *Dot) Render(shimmer bool) error {
+	//Show the dot
+	if err := gDotTexture.Render(d.mPosX, d.mPosY, nil, 0, nil, sdl.FLIP_NONE); err != nil {
+		return fmt.Errorf("could not render dot: %v", err)
+	}
+
+	//Show particles on top of the dot
+	if err := d.renderParticles(shimmer); err != nil {
+		return fmt.Errorf("could not render dot's particle: %v", err)
+	}
+
+	return nil
+}
+
+//Shows the particles
+func (d *Dot) renderParticles(shimmer bool) error {
+	//Go through particles
+	for i := range d.particles {
+		//Delete and replace dead particles
+		if d.particles[i].IsDead() {
+			d.particles[i] = NewParticle(d.mPosX, d.mPosY)
+		}
+	}
+
+	//Show particles
+	for i := range d.particles {
+		if err := d.particles[i].Render(shimmer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+//ManifestRect is a rectangle in the manifest
+type ManifestRect struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	W int `json:"w"`
+	H int `json:"h"`
+}
+
+//ManifestSprite is where a sprite ended up in the atlas
+type ManifestSprite struct {
+	//File name without extension
+	Name string `json:"name"`
+
+	//Pixels of the sprite in the atlas, without padding or extrusion
+	Frame ManifestRect `json:"frame"`
+
+	//Transparent borders were cut off
+	Trimmed bool `json:"trimmed"`
+
+	//Where the trimmed pixels go inside the original image and the original image size
+	SourceX int `json:"sourceX"`
+	SourceY int `json:"sourceY"`
+	SourceW int `json:"sourceW"`
+	SourceH int `json:"sourceH"`
+}
+
+//Manifest describes an atlas image
+type Manifest struct {
+	//Atlas image file, relative to the manifest
+	Image string `json:"image"`
+
+	//Atlas dimensions
+	Width  int `json:"width"`
+	Height int `json:"height"`
+
+	//Empty pixels between sprites and copies of each sprite's edge around it
+	Padding int `json:"padding"`
+	Extrude int `json:"extrude"`
+
+	Sprites []ManifestSprite `json:"sprites"`
+}
+
+//SaveToFile writes the manifest as indented JSON
+func (m *Manifest) SaveToFile(path string) error {
+	data, err := json.MarshalIndent(m, "", "\t")
+	if err != nil {
+		return fmt.Errorf("could not encode manifest: %v", err)
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("could not write manifest %v: %v", path, err)
+	}
+
+	return nil
+}
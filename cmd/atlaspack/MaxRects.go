@@ -0,0 +1,107 @@
+package main
+
+import (
+	"image"
+)
+
+//MaxRectsPacker places rectangles in a bin keeping track of every maximal free rectangle
+type MaxRectsPacker struct {
+	//Bin dimensions
+	mWidth  int
+	mHeight int
+
+	//Free rectangles, they can overlap each other
+	mFree []image.Rectangle
+}
+
+//NewMaxRectsPacker creates an empty bin
+func NewMaxRectsPacker(width, height int) *MaxRectsPacker {
+	return &MaxRectsPacker{mWidth: width, mHeight: height, mFree: []image.Rectangle{image.Rect(0, 0, width, height)}}
+}
+
+//Insert places a rectangle where it fits best against the short side of a free rectangle
+func (mp *MaxRectsPacker) Insert(width, height int) (image.Rectangle, bool) {
+	var best image.Rectangle
+	bestShort, bestLong := -1, -1
+
+	for _, free := range mp.mFree {
+		if free.Dx() < width || free.Dy() < height {
+			continue
+		}
+
+		//Leftover space on each side
+		leftoverX := free.Dx() - width
+		leftoverY := free.Dy() - height
+		short, long := leftoverX, leftoverY
+		if short > long {
+			short, long = long, short
+		}
+
+		if bestShort == -1 || short < bestShort || (short == bestShort && long < bestLong) {
+			best = image.Rect(free.Min.X, free.Min.Y, free.Min.X+width, free.Min.Y+height)
+			bestShort, bestLong = short, long
+		}
+	}
+
+	//Nothing fits
+	if bestShort == -1 {
+		return image.Rectangle{}, false
+	}
+
+	mp.place(best)
+	return best, true
+}
+
+//place cuts a used rectangle out of the free rectangles
+func (mp *MaxRectsPacker) place(used image.Rectangle) {
+	free := make([]image.Rectangle, 0, len(mp.mFree)+4)
+	for _, f := range mp.mFree {
+		if !f.Overlaps(used) {
+			free = append(free, f)
+			continue
+		}
+
+		//Keep the parts of the free rectangle on each side of the used one
+		if used.Min.X > f.Min.X {
+			free = append(free, image.Rect(f.Min.X, f.Min.Y, used.Min.X, f.Max.Y))
+		}
+		if used.Max.X < f.Max.X {
+			free = append(free, image.Rect(used.Max.X, f.Min.Y, f.Max.X, f.Max.Y))
+		}
+		if used.Min.Y > f.Min.Y {
+			free = append(free, image.Rect(f.Min.X, f.Min.Y, f.Max.X, used.Min.Y))
+		}
+		if used.Max.Y < f.Max.Y {
+			free = append(free, image.Rect(f.Min.X, used.Max.Y, f.Max.X, f.Max.Y))
+		}
+	}
+
+	mp.mFree = pruneContained(free)
+}
+
+//pruneContained removes free rectangles that are inside another one
+func pruneContained(rects []image.Rectangle) []image.Rectangle {
+	pruned := make([]image.Rectangle, 0, len(rects))
+	for i, r := range rects {
+		contained := false
+		for j, other := range rects {
+			if i == j || !r.In(other) {
+				continue
+			}
+
+			//Of two equal rectangles keep the first
+			if r.Eq(other) && i < j {
+				continue
+			}
+
+			contained = true
+			break
+		}
+
+		if !contained {
+			pruned = append(pruned, r)
+		}
+	}
+
+	return pruned
+}
@@ -0,0 +1,261 @@
+//Command atlaspack packs a folder of PNGs into one atlas image and a JSON manifest
+//
+//Usage:
+//
+//	atlaspack -in sprites -out atlas.png -manifest atlas.json [-padding 2] [-extrude 1] [-trim] [-max 2048]
+package main
+
+import (
+	"flag"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+//sprite is an image waiting to be packed
+type sprite struct {
+	mName  string
+	mImage image.Image
+
+	//Part of the image that gets packed, all of it unless trimmed
+	mBounds image.Rectangle
+
+	//Where it was packed, including padding and extrusion
+	mCell image.Rectangle
+}
+
+var (
+	inDir        = flag.String("in", ".", "folder of PNG images to pack")
+	outImage     = flag.String("out", "atlas.png", "atlas image to write")
+	outManifest  = flag.String("manifest", "atlas.json", "JSON manifest to write")
+	padding      = flag.Int("padding", 2, "empty pixels between sprites")
+	extrude      = flag.Int("extrude", 1, "pixels of each sprite's edge repeated around it")
+	trim         = flag.Bool("trim", true, "cut off transparent borders")
+	maxAtlasSize = flag.Int("max", 2048, "largest atlas width and height")
+)
+
+func main() {
+	flag.Parse()
+
+	//Negative sizes would overlap sprites or read outside them
+	if *padding < 0 || *extrude < 0 || *maxAtlasSize <= 0 {
+		fmt.Fprintf(flag.CommandLine.Output(), "-padding and -extrude can't be negative and -max must be positive\n")
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	sprites, err := loadSprites(*inDir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(sprites) == 0 {
+		log.Fatalf("no PNG images in %v", *inDir)
+	}
+
+	width, height, err := pack(sprites)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	atlas := render(sprites, width, height)
+	if err := savePNG(*outImage, atlas); err != nil {
+		log.Fatal(err)
+	}
+
+	manifest := buildManifest(sprites, width, height)
+	if err := manifest.SaveToFile(*outManifest); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("Packed %d sprites into %dx%d %v\n", len(sprites), width, height, *outImage)
+}
+
+//loadSprites loads every PNG in a folder, trimming them if asked to
+func loadSprites(dir string) ([]*sprite, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.png"))
+	if err != nil {
+		return nil, fmt.Errorf("could not list %v: %v", dir, err)
+	}
+	sort.Strings(paths)
+
+	sprites := make([]*sprite, 0, len(paths))
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("could not open %v: %v", path, err)
+		}
+		img, err := png.Decode(file)
+		file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("could not decode %v: %v", path, err)
+		}
+
+		s := &sprite{
+			mName:   strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)),
+			mImage:  img,
+			mBounds: img.Bounds(),
+		}
+		if *trim {
+			s.mBounds = opaqueBounds(img)
+		}
+
+		sprites = append(sprites, s)
+	}
+
+	return sprites, nil
+}
+
+//opaqueBounds gets the smallest rectangle holding every visible pixel
+func opaqueBounds(img image.Image) image.Rectangle {
+	bounds := img.Bounds()
+	opaque := image.Rectangle{}
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if _, _, _, a := img.At(x, y).RGBA(); a != 0 {
+				opaque = opaque.Union(image.Rect(x, y, x+1, y+1))
+			}
+		}
+	}
+
+	//Fully transparent images keep one pixel so they still have a region
+	if opaque.Empty() {
+		return image.Rect(bounds.Min.X, bounds.Min.Y, bounds.Min.X+1, bounds.Min.Y+1)
+	}
+
+	return opaque
+}
+
+//pack finds the smallest power of two atlas, capped at the maximum size, the sprites fit in and places them
+func pack(sprites []*sprite) (int, int, error) {
+	//Big sprites first pack tighter
+	order := make([]*sprite, len(sprites))
+	copy(order, sprites)
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := order[i].mBounds, order[j].mBounds
+		return a.Dx()*a.Dy() > b.Dx()*b.Dy()
+	})
+
+	//Grow width then height until everything fits, the last step may stop short of a power of two at the limit
+	start := min(64, *maxAtlasSize)
+	for width, height := start, start; ; {
+		if packInto(order, width, height) {
+			return width, height, nil
+		}
+
+		//Both sides at the limit
+		if width == *maxAtlasSize && height == *maxAtlasSize {
+			break
+		}
+
+		if (width <= height && width < *maxAtlasSize) || height == *maxAtlasSize {
+			width = min(width*2, *maxAtlasSize)
+		} else {
+			height = min(height*2, *maxAtlasSize)
+		}
+	}
+
+	return 0, 0, fmt.Errorf("sprites don't fit in a %dx%d atlas", *maxAtlasSize, *maxAtlasSize)
+}
+
+//packInto tries to place every sprite in an atlas size
+func packInto(sprites []*sprite, width, height int) bool {
+	//Padding only goes between sprites so the bin gets it back on the right and bottom
+	packer := NewMaxRectsPacker(width+*padding, height+*padding)
+
+	for _, s := range sprites {
+		cell, ok := packer.Insert(s.mBounds.Dx()+2**extrude+*padding, s.mBounds.Dy()+2**extrude+*padding)
+		if !ok {
+			return false
+		}
+
+		//Padding isn't part of the cell
+		cell.Max = cell.Max.Sub(image.Pt(*padding, *padding))
+		s.mCell = cell
+	}
+
+	return true
+}
+
+//render draws every sprite in its cell, extruding its edges into the border
+func render(sprites []*sprite, width, height int) *image.NRGBA {
+	atlas := image.NewNRGBA(image.Rect(0, 0, width, height))
+
+	for _, s := range sprites {
+		frame := s.mCell.Inset(*extrude)
+		draw.Draw(atlas, frame, s.mImage, s.mBounds.Min, draw.Src)
+
+		//Repeat edge pixels so linear filtering at the edges samples the sprite and not its neighbours
+		for y := s.mCell.Min.Y; y < s.mCell.Max.Y; y++ {
+			for x := s.mCell.Min.X; x < s.mCell.Max.X; x++ {
+				if image.Pt(x, y).In(frame) {
+					continue
+				}
+
+				atlas.Set(x, y, atlas.At(clamp(x, frame.Min.X, frame.Max.X-1), clamp(y, frame.Min.Y, frame.Max.Y-1)))
+			}
+		}
+	}
+
+	return atlas
+}
+
+//buildManifest describes where every sprite went, in name order
+func buildManifest(sprites []*sprite, width, height int) *Manifest {
+	manifest := &Manifest{
+		Image:   filepath.Base(*outImage),
+		Width:   width,
+		Height:  height,
+		Padding: *padding,
+		Extrude: *extrude,
+	}
+
+	for _, s := range sprites {
+		frame := s.mCell.Inset(*extrude)
+		source := s.mImage.Bounds()
+
+		manifest.Sprites = append(manifest.Sprites, ManifestSprite{
+			Name:    s.mName,
+			Frame:   ManifestRect{X: frame.Min.X, Y: frame.Min.Y, W: frame.Dx(), H: frame.Dy()},
+			Trimmed: !s.mBounds.Eq(source),
+			SourceX: s.mBounds.Min.X - source.Min.X,
+			SourceY: s.mBounds.Min.Y - source.Min.Y,
+			SourceW: source.Dx(),
+			SourceH: source.Dy(),
+		})
+	}
+
+	return manifest
+}
+
+//savePNG writes an image
+func savePNG(path string, img image.Image) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("could not create %v: %v", path, err)
+	}
+
+	if err := png.Encode(file, img); err != nil {
+		file.Close()
+		return fmt.Errorf("could not encode %v: %v", path, err)
+	}
+
+	return file.Close()
+}
+
+//clamp keeps a value in a range
+func clamp(value, low, high int) int {
+	if value < low {
+		return low
+	}
+	if value > high {
+		return high
+	}
+	return value
+}